@@ -0,0 +1,98 @@
+package artifact
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+
+	"moji/src/evaluator"
+	"moji/src/vm"
+)
+
+// FormatVersion identifies the layout of the program inside an artifact.
+// It must be bumped whenever the parser's statement forms, the resolver's
+// forms or the VM's opcodes change, so old artifacts are rejected instead
+// of misbehaving.
+const FormatVersion = 13
+
+// magic starts every artifact; the NUL byte keeps it from being mistaken
+// for a source file
+var magic = []byte("\x00MJC")
+
+const headerSize = 4 + 2 + 4 // magic, format version, payload length
+
+// Program is a parsed and compiled script, ready to run without scanning
+// or parsing its source again
+type Program struct {
+	Filename   string                // Source file it was compiled from
+	Source     string                // Source text, for error reports and tools
+	Statements []string              // Line-marked statements for the evaluator
+	Chunk      *vm.Chunk             // Bytecode for the VM, whose line table is the source map
+	Numeric    evaluator.NumericMode // Mode its constants were folded in
+}
+
+// IsArtifact reports whether data looks like a compiled artifact
+func IsArtifact(data []byte) bool {
+	return bytes.HasPrefix(data, magic)
+}
+
+// Write encodes the program as a versioned, checksummed artifact
+func Write(w io.Writer, program *Program) error {
+	var payload bytes.Buffer
+	if err := gob.NewEncoder(&payload).Encode(program); err != nil {
+		return err
+	}
+
+	header := make([]byte, headerSize)
+	copy(header, magic)
+	binary.BigEndian.PutUint16(header[4:], FormatVersion)
+	binary.BigEndian.PutUint32(header[6:], uint32(payload.Len()))
+	checksum := sha256.Sum256(payload.Bytes())
+
+	for _, part := range [][]byte{header, payload.Bytes(), checksum[:]} {
+		if _, err := w.Write(part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Read decodes an artifact, rejecting those written for another format
+// version and those whose checksum does not match
+func Read(data []byte) (*Program, error) {
+	if !IsArtifact(data) {
+		return nil, errors.New("not a compiled Moji artifact")
+	}
+	if len(data) < headerSize {
+		return nil, errors.New("artifact is truncated")
+	}
+
+	version := binary.BigEndian.Uint16(data[4:])
+	if version != FormatVersion {
+		return nil, fmt.Errorf("artifact was compiled for format version %d, but this interpreter runs version %d; recompile it", version, FormatVersion)
+	}
+
+	length := int(binary.BigEndian.Uint32(data[6:]))
+	if len(data) != headerSize+length+sha256.Size {
+		return nil, errors.New("artifact is truncated")
+	}
+	payload := data[headerSize : headerSize+length]
+	checksum := sha256.Sum256(payload)
+	if !bytes.Equal(checksum[:], data[headerSize+length:]) {
+		return nil, errors.New("artifact is corrupt: checksum mismatch")
+	}
+
+	program := &Program{}
+	if err := gob.NewDecoder(bytes.NewReader(payload)).Decode(program); err != nil {
+		return nil, fmt.Errorf("artifact is corrupt: %v", err)
+	}
+	if program.Chunk == nil {
+		// An empty program has nothing to encode in its chunk
+		program.Chunk = &vm.Chunk{}
+	}
+	return program, nil
+}
@@ -0,0 +1,304 @@
+package coverage
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"moji/src/evaluator"
+	"moji/src/resolver"
+	"moji/src/sexpr"
+)
+
+// statementKey identifies a statement by its line and its resolved form,
+// which is what the evaluator reports as it runs it. Identical statements
+// on the same line can't be told apart and share a count.
+type statementKey struct {
+	line int
+	text string
+}
+
+// branchCounts records how often each side of an if statement ran
+type branchCounts struct {
+	then  int
+	other int
+}
+
+// Coverage records which statements and if/else branches were executed
+type Coverage struct {
+	filename   string
+	lines      []string
+	statements map[statementKey]int           // Execution count per statement
+	order      []statementKey                 // Statements in source order
+	branches   map[statementKey]*branchCounts // Branch counts per if statement
+	currentIf  statementKey                   // The if statement that started last
+}
+
+// NewCoverage prepares coverage for the given parsed, line-marked statements
+func NewCoverage(filename string, source string, statements []string) *Coverage {
+	c := &Coverage{
+		filename:   filename,
+		lines:      strings.Split(source, "\n"),
+		statements: make(map[statementKey]int),
+		branches:   make(map[statementKey]*branchCounts),
+	}
+
+	for _, stmt := range resolver.Resolve(statements) {
+		if n, err := sexpr.Read(stmt); err == nil {
+			c.addStatements(n)
+		}
+	}
+
+	return c
+}
+
+// addStatements records every line-marked statement in n
+func (c *Coverage) addStatements(n *sexpr.Node) {
+	if n.Head() == "at" && len(n.List) == 3 {
+		line, err := strconv.Atoi(n.List[1].Atom)
+		inner := n.List[2]
+		// Blocks are covered through the statements they contain
+		if err == nil && inner.Head() != "block" {
+			key := statementKey{line, inner.String()}
+			if _, ok := c.statements[key]; !ok {
+				c.statements[key] = 0
+				c.order = append(c.order, key)
+			}
+			if inner.Head() == "if" {
+				c.branches[key] = &branchCounts{}
+			}
+		}
+	}
+	for _, child := range n.List {
+		c.addStatements(child)
+	}
+}
+
+// Hooks returns the evaluator callbacks that record coverage
+func (c *Coverage) Hooks() *evaluator.Hooks {
+	return &evaluator.Hooks{
+		BeforeStatement: c.beforeStatement,
+		OnBranch:        c.onBranch,
+	}
+}
+
+func (c *Coverage) beforeStatement(line int, stmt string, depth int) error {
+	key := statementKey{line, stmt}
+	if _, ok := c.statements[key]; ok {
+		c.statements[key]++
+	}
+	// Only its condition runs before the if statement picks a branch
+	if strings.HasPrefix(stmt, "(if ") {
+		c.currentIf = key
+	}
+	return nil
+}
+
+func (c *Coverage) onBranch(line int, branch string) {
+	counts, ok := c.branches[c.currentIf]
+	if !ok || c.currentIf.line != line {
+		return
+	}
+	if branch == "then" {
+		counts.then++
+	} else {
+		counts.other++
+	}
+}
+
+// Totals returns covered and total counts for statements and branches.
+// Every if statement has two branches, even without an else clause.
+func (c *Coverage) Totals() (coveredStatements, statements, coveredBranches, branches int) {
+	for _, count := range c.statements {
+		statements++
+		if count > 0 {
+			coveredStatements++
+		}
+	}
+	for _, counts := range c.branches {
+		branches += 2
+		if counts.then > 0 {
+			coveredBranches++
+		}
+		if counts.other > 0 {
+			coveredBranches++
+		}
+	}
+	return
+}
+
+// WriteSummary writes the coverage percentages and the lines that missed
+func (c *Coverage) WriteSummary(w io.Writer) {
+	coveredStatements, statements, coveredBranches, branches := c.Totals()
+
+	fmt.Fprintf(w, "Coverage of %s\n", c.filename)
+	fmt.Fprintf(w, "  statements: %d/%d (%s)\n", coveredStatements, statements, percent(coveredStatements, statements))
+	fmt.Fprintf(w, "  branches:   %d/%d (%s)\n", coveredBranches, branches, percent(coveredBranches, branches))
+
+	missed := []string{}
+	for _, key := range c.sortedStatements() {
+		var entry string
+		if c.statements[key] == 0 {
+			entry = fmt.Sprintf("line %d", key.line)
+		} else if counts, ok := c.branches[key]; ok && counts.then == 0 {
+			entry = fmt.Sprintf("line %d (then branch)", key.line)
+		} else if ok && counts.other == 0 {
+			entry = fmt.Sprintf("line %d (else branch)", key.line)
+		}
+		// Several statements on one line that missed are listed once
+		if entry != "" && (len(missed) == 0 || missed[len(missed)-1] != entry) {
+			missed = append(missed, entry)
+		}
+	}
+	if len(missed) > 0 {
+		fmt.Fprintf(w, "  not covered: %s\n", strings.Join(missed, ", "))
+	}
+}
+
+// lineCoverage sums up the statements that start on one line
+type lineCoverage struct {
+	statements int
+	missed     int
+	count      int             // Executions of the first statement on the line
+	branches   []*branchCounts // One per if statement, in source order
+}
+
+func (c *Coverage) lineCoverage() map[int]*lineCoverage {
+	lines := make(map[int]*lineCoverage)
+	for _, key := range c.sortedStatements() {
+		l, ok := lines[key.line]
+		if !ok {
+			l = &lineCoverage{count: c.statements[key]}
+			lines[key.line] = l
+		}
+		l.statements++
+		if c.statements[key] == 0 {
+			l.missed++
+		}
+		if counts, ok := c.branches[key]; ok {
+			l.branches = append(l.branches, counts)
+		}
+	}
+	return lines
+}
+
+// partial reports whether a line ran but left a statement or branch out
+func (l *lineCoverage) partial() bool {
+	if l.missed == l.statements {
+		return false
+	}
+	if l.missed > 0 {
+		return true
+	}
+	for _, counts := range l.branches {
+		if counts.then == 0 || counts.other == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// describe lists the branch counts of each if statement on the line and
+// how many of its statements ran, when only some of them did
+func (l *lineCoverage) describe() string {
+	parts := []string{}
+	for _, counts := range l.branches {
+		parts = append(parts, fmt.Sprintf("[then %d, else %d]", counts.then, counts.other))
+	}
+	if l.missed > 0 && l.missed < l.statements {
+		parts = append(parts, fmt.Sprintf("[%d of %d statements ran]", l.statements-l.missed, l.statements))
+	}
+	return strings.Join(parts, " ")
+}
+
+// WriteAnnotated writes the source with each statement line prefixed by the
+// execution count of its first statement, "#####" for lines whose
+// statements never ran, and the branch counts of if statements appended
+func (c *Coverage) WriteAnnotated(w io.Writer) {
+	lines := c.lineCoverage()
+	for i, text := range c.lines {
+		line := i + 1
+		text = strings.TrimRight(text, "\r")
+
+		l, isStatement := lines[line]
+		prefix := "      "
+		suffix := ""
+		switch {
+		case isStatement && l.missed == l.statements:
+			prefix = "#####:"
+		case isStatement:
+			prefix = fmt.Sprintf("%5d:", l.count)
+		}
+		if isStatement && l.describe() != "" {
+			suffix = "    " + l.describe()
+		}
+		fmt.Fprintf(w, "%s %4d  %s%s\n", prefix, line, text, suffix)
+	}
+}
+
+// WriteHTML writes a standalone HTML page with covered lines in green,
+// missed lines in red and partially covered branches in yellow
+func (c *Coverage) WriteHTML(w io.Writer) {
+	coveredStatements, statements, coveredBranches, branches := c.Totals()
+
+	fmt.Fprintln(w, "<!DOCTYPE html>")
+	fmt.Fprintln(w, `<html><head><meta charset="utf-8">`)
+	fmt.Fprintf(w, "<title>Coverage of %s</title>\n", html.EscapeString(c.filename))
+	fmt.Fprintln(w, `<style>
+body { font-family: sans-serif; }
+pre { font-size: 14px; line-height: 1.4; }
+.line { display: block; }
+.num, .count { display: inline-block; text-align: right; color: #888; }
+.num { width: 4em; } .count { width: 5em; margin-right: 1em; }
+.covered { background: #dfd; } .missed { background: #fdd; } .partial { background: #ffc; }
+</style></head><body>`)
+	fmt.Fprintf(w, "<h1>Coverage of %s</h1>\n", html.EscapeString(c.filename))
+	fmt.Fprintf(w, "<p>Statements: %d/%d (%s). Branches: %d/%d (%s).</p>\n",
+		coveredStatements, statements, percent(coveredStatements, statements),
+		coveredBranches, branches, percent(coveredBranches, branches))
+
+	fmt.Fprintln(w, "<pre>")
+	lines := c.lineCoverage()
+	for i, text := range c.lines {
+		line := i + 1
+		class := ""
+		countText := ""
+		title := ""
+		if l, ok := lines[line]; ok {
+			countText = strconv.Itoa(l.count)
+			switch {
+			case l.missed == l.statements:
+				class = "missed"
+			case l.partial():
+				class = "partial"
+			default:
+				class = "covered"
+			}
+			if description := l.describe(); description != "" {
+				title = fmt.Sprintf(` title="%s"`, html.EscapeString(description))
+			}
+		}
+		fmt.Fprintf(w, `<span class="line %s"%s><span class="num">%d</span> <span class="count">%s</span>%s</span>`+"\n",
+			class, title, line, countText, html.EscapeString(strings.TrimRight(text, "\r")))
+	}
+	fmt.Fprintln(w, "</pre>")
+	fmt.Fprintln(w, "</body></html>")
+}
+
+// sortedStatements returns the statements ordered by line, keeping source
+// order within a line
+func (c *Coverage) sortedStatements() []statementKey {
+	keys := append([]statementKey(nil), c.order...)
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].line < keys[j].line })
+	return keys
+}
+
+func percent(covered, total int) string {
+	if total == 0 {
+		return "100.0%"
+	}
+	return fmt.Sprintf("%.1f%%", float64(covered)*100/float64(total))
+}
@@ -0,0 +1,496 @@
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"moji/src/evaluator"
+	"moji/src/parser"
+	"moji/src/scanner"
+)
+
+// errTerminated stops the program when the client disconnects
+var errTerminated = errors.New("dap: terminated")
+
+// The evaluator runs a single program, so everything happens on one thread
+// with one stack frame.
+const (
+	threadID = 1
+	frameID  = 1
+)
+
+type stepMode int
+
+const (
+	modeContinue stepMode = iota // Stop only at breakpoints
+	modeStep                     // Stop at the next statement
+	modeNext                     // Stop at the next statement at the same depth or shallower
+	modeOut                      // Stop at the next statement in an enclosing block
+)
+
+// Server speaks the Debug Adapter Protocol for a single Moji program
+type Server struct {
+	reader  *bufio.Reader
+	writer  io.Writer
+	writeMu sync.Mutex
+	seq     int
+
+	program     string
+	stopOnEntry bool
+	noDebug     bool
+	evaluator   *evaluator.Evaluator
+	started     bool
+	done        chan struct{}
+	resume      chan struct{}
+
+	// Fields below are shared with the program goroutine and guarded by mu
+	mu             sync.Mutex
+	breakpoints    map[string]map[int]bool // Lines by absolute source path
+	mode           stepMode
+	stepDepth      int
+	pauseRequested bool
+	terminating    bool
+	stopped        bool
+	stoppedLine    int
+	stoppedDepth   int
+	scopes         []*evaluator.Environment
+}
+
+// NewServer creates a server reading requests from in and writing to out
+func NewServer(in io.Reader, out io.Writer) *Server {
+	return &Server{
+		reader:      bufio.NewReader(in),
+		writer:      out,
+		done:        make(chan struct{}),
+		resume:      make(chan struct{}),
+		breakpoints: make(map[string]map[int]bool),
+	}
+}
+
+// Serve handles requests until the client disconnects or the input ends
+func (s *Server) Serve() error {
+	for {
+		body, err := readMessage(s.reader)
+		if err == io.EOF {
+			s.terminate()
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var req request
+		if err := json.Unmarshal(body, &req); err != nil {
+			return fmt.Errorf("invalid message: %v", err)
+		}
+		if req.Type != "request" {
+			continue
+		}
+
+		if req.Command == "disconnect" || req.Command == "terminate" {
+			s.terminate()
+			s.respond(req, nil)
+			if req.Command == "disconnect" {
+				return nil
+			}
+			continue
+		}
+
+		s.handle(req)
+	}
+}
+
+func (s *Server) handle(req request) {
+	switch req.Command {
+	case "initialize":
+		s.respond(req, map[string]interface{}{
+			"supportsConfigurationDoneRequest": true,
+			"supportsEvaluateForHovers":        true,
+			"supportsTerminateRequest":         true,
+		})
+		s.sendEvent("initialized", nil)
+	case "launch":
+		s.launch(req)
+	case "setBreakpoints":
+		s.setBreakpoints(req)
+	case "setExceptionBreakpoints":
+		s.respond(req, nil)
+	case "configurationDone":
+		s.respond(req, nil)
+		s.start()
+	case "threads":
+		s.respond(req, map[string]interface{}{
+			"threads": []map[string]interface{}{{"id": threadID, "name": "main"}},
+		})
+	case "stackTrace":
+		s.stackTrace(req)
+	case "scopes":
+		s.scopesRequest(req)
+	case "variables":
+		s.variables(req)
+	case "evaluate":
+		s.evaluate(req)
+	case "continue":
+		s.respond(req, map[string]interface{}{"allThreadsContinued": true})
+		s.resumeProgram(modeContinue)
+	case "next":
+		s.respond(req, nil)
+		s.resumeProgram(modeNext)
+	case "stepIn":
+		s.respond(req, nil)
+		s.resumeProgram(modeStep)
+	case "stepOut":
+		s.respond(req, nil)
+		s.resumeProgram(modeOut)
+	case "pause":
+		s.mu.Lock()
+		s.pauseRequested = s.started && !s.stopped
+		s.mu.Unlock()
+		s.respond(req, nil)
+	default:
+		s.fail(req, fmt.Sprintf("Unsupported request '%s'.", req.Command))
+	}
+}
+
+func (s *Server) launch(req request) {
+	var args launchArguments
+	if err := json.Unmarshal(req.Arguments, &args); err != nil || args.Program == "" {
+		s.fail(req, "Launch requires a 'program' to run.")
+		return
+	}
+
+	contents, err := os.ReadFile(args.Program)
+	if err != nil {
+		s.fail(req, fmt.Sprintf("Error reading file: %v", err))
+		return
+	}
+
+	sc := scanner.NewScanner(string(contents))
+	tokens := sc.ScanTokens()
+	if sc.HasError() {
+		s.fail(req, fmt.Sprintf("Could not scan %s.", args.Program))
+		return
+	}
+
+	s.program = sourcePath(args.Program)
+	s.stopOnEntry = args.StopOnEntry
+	s.noDebug = args.NoDebug
+	s.evaluator = evaluator.NewEvaluator(parser.NewParser(tokens))
+	searchPath := append(args.ModulePath, filepath.SplitList(os.Getenv("MOJI_PATH"))...)
+	s.evaluator.SetModules(evaluator.NewModuleLoader(searchPath), args.Program)
+	s.evaluator.SetOutput(outputWriter{server: s, category: "stdout"})
+	s.evaluator.SetHooks(&evaluator.Hooks{BeforeStatement: s.beforeStatement})
+	s.respond(req, nil)
+}
+
+func (s *Server) setBreakpoints(req request) {
+	var args setBreakpointsArguments
+	if err := json.Unmarshal(req.Arguments, &args); err != nil {
+		s.fail(req, "Invalid setBreakpoints arguments.")
+		return
+	}
+
+	// Breakpoints may be set before launch, and in files other than the
+	// program, which never stop it
+	path := sourcePath(args.Source.Path)
+	lines := make(map[int]bool)
+	verified := make([]breakpoint, 0, len(args.Breakpoints))
+	for _, bp := range args.Breakpoints {
+		lines[bp.Line] = true
+		verified = append(verified, breakpoint{Verified: s.program == "" || path == s.program, Line: bp.Line})
+	}
+
+	s.mu.Lock()
+	s.breakpoints[path] = lines
+	s.mu.Unlock()
+
+	s.respond(req, map[string]interface{}{"breakpoints": verified})
+}
+
+// start runs the launched program in its own goroutine
+func (s *Server) start() {
+	if s.evaluator == nil || s.started {
+		return
+	}
+	s.started = true
+
+	go func() {
+		defer close(s.done)
+		err := s.evaluator.Execute()
+
+		exitCode := 0
+		switch {
+		case err == nil, err == errTerminated:
+		case err == evaluator.ErrSyntax:
+			s.sendOutput("stderr", "Syntax error in program.\n")
+			exitCode = 65
+		default:
+			s.sendOutput("stderr", err.Error()+"\n")
+			exitCode = 70
+		}
+
+		s.sendEvent("exited", map[string]interface{}{"exitCode": exitCode})
+		s.sendEvent("terminated", nil)
+	}()
+}
+
+// beforeStatement runs on the program goroutine and blocks while stopped
+func (s *Server) beforeStatement(line int, stmt string, depth int) error {
+	s.mu.Lock()
+	if s.terminating {
+		s.mu.Unlock()
+		return errTerminated
+	}
+
+	// Blocks only group statements, stop at their contents instead
+	if s.noDebug || strings.HasPrefix(stmt, "(block") {
+		s.mu.Unlock()
+		return nil
+	}
+
+	reason := ""
+	switch {
+	case s.pauseRequested:
+		reason = "pause"
+	case s.stopOnEntry:
+		reason = "entry"
+		s.stopOnEntry = false
+	case s.mode == modeStep,
+		s.mode == modeNext && depth <= s.stepDepth,
+		s.mode == modeOut && depth < s.stepDepth:
+		reason = "step"
+	case s.breakpoints[s.program][line]:
+		reason = "breakpoint"
+	}
+
+	if reason == "" {
+		s.mu.Unlock()
+		return nil
+	}
+
+	s.pauseRequested = false
+	s.stopped = true
+	s.stoppedLine = line
+	s.stoppedDepth = depth
+	s.scopes = s.scopes[:0]
+	for env := s.evaluator.Environment(); env != nil; env = env.Enclosing() {
+		s.scopes = append(s.scopes, env)
+	}
+	s.mu.Unlock()
+
+	s.sendEvent("stopped", map[string]interface{}{
+		"reason":            reason,
+		"threadId":          threadID,
+		"allThreadsStopped": true,
+	})
+
+	<-s.resume
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.terminating {
+		return errTerminated
+	}
+	return nil
+}
+
+func (s *Server) resumeProgram(mode stepMode) {
+	s.mu.Lock()
+	if !s.stopped {
+		s.mu.Unlock()
+		return
+	}
+	s.mode = mode
+	s.stepDepth = s.stoppedDepth
+	s.stopped = false
+	s.mu.Unlock()
+
+	s.resume <- struct{}{}
+}
+
+// terminate stops a running program and waits for it to finish
+func (s *Server) terminate() {
+	s.mu.Lock()
+	s.terminating = true
+	wasStopped := s.stopped
+	s.stopped = false
+	s.mu.Unlock()
+
+	if wasStopped {
+		s.resume <- struct{}{}
+	}
+	if s.started {
+		<-s.done
+	}
+}
+
+func (s *Server) stackTrace(req request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	frames := []stackFrame{}
+	if s.stopped {
+		frames = append(frames, stackFrame{
+			ID:     frameID,
+			Name:   "main",
+			Source: source{Name: filepath.Base(s.program), Path: s.program},
+			Line:   s.stoppedLine,
+			Column: 1,
+		})
+	}
+	s.respond(req, map[string]interface{}{"stackFrames": frames, "totalFrames": len(frames)})
+}
+
+// scopesRequest lists one scope per environment, innermost block first
+func (s *Server) scopesRequest(req request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	scopes := []scope{}
+	for i := range s.scopes {
+		name := "Globals"
+		if i < len(s.scopes)-1 {
+			name = fmt.Sprintf("Block %d", len(s.scopes)-1-i)
+		}
+		scopes = append(scopes, scope{Name: name, VariablesReference: i + 1})
+	}
+	s.respond(req, map[string]interface{}{"scopes": scopes})
+}
+
+func (s *Server) variables(req request) {
+	var args variablesArguments
+	if err := json.Unmarshal(req.Arguments, &args); err != nil {
+		s.fail(req, "Invalid variables arguments.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	variables := []variable{}
+	if s.stopped && args.VariablesReference >= 1 && args.VariablesReference <= len(s.scopes) {
+		env := s.scopes[args.VariablesReference-1]
+		for _, name := range env.Names() {
+			value, _ := env.Get(name)
+			v := variable{Name: name, Value: value, Type: valueType(value)}
+			if env.IsConstant(name) {
+				v.PresentationHint = &presentationHint{Attributes: []string{"constant", "readOnly"}}
+			}
+			variables = append(variables, v)
+		}
+	}
+	s.respond(req, map[string]interface{}{"variables": variables})
+}
+
+func (s *Server) evaluate(req request) {
+	var args evaluateArguments
+	if err := json.Unmarshal(req.Arguments, &args); err != nil {
+		s.fail(req, "Invalid evaluate arguments.")
+		return
+	}
+
+	s.mu.Lock()
+	stopped := s.stopped
+	s.mu.Unlock()
+	if !stopped {
+		s.fail(req, "The program must be stopped to evaluate expressions.")
+		return
+	}
+
+	sc := scanner.NewScanner(args.Expression)
+	tokens := sc.ScanTokens()
+	if sc.HasError() {
+		s.fail(req, "Invalid expression.")
+		return
+	}
+	expr, ok := parser.NewParser(tokens).ParseExpression()
+	if !ok {
+		s.fail(req, "Invalid expression.")
+		return
+	}
+
+	// The program goroutine is blocked while stopped, so the evaluator is ours
+	value, err := s.evaluator.Eval(expr)
+	if err != nil {
+		s.fail(req, err.Error())
+		return
+	}
+	s.respond(req, map[string]interface{}{"result": value, "type": valueType(value), "variablesReference": 0})
+}
+
+// valueType describes an evaluator value for display in the client
+func valueType(value string) string {
+	switch {
+	case value == "nil":
+		return "nil"
+	case value == "true" || value == "false":
+		return "boolean"
+	case strings.HasPrefix(value, "\""):
+		return "string"
+	case strings.HasPrefix(value, "["):
+		return "list"
+	case strings.HasPrefix(value, "{"):
+		return "map"
+	default:
+		return "number"
+	}
+}
+
+// sourcePath makes a source path absolute, so the paths of breakpoints and
+// of the program compare equal however the client spelled them
+func sourcePath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return path
+}
+
+func (s *Server) respond(req request, body interface{}) {
+	s.send(&response{Type: "response", RequestSeq: req.Seq, Success: true, Command: req.Command, Body: body})
+}
+
+func (s *Server) fail(req request, message string) {
+	s.send(&response{Type: "response", RequestSeq: req.Seq, Success: false, Command: req.Command, Message: message})
+}
+
+func (s *Server) sendEvent(name string, body interface{}) {
+	s.send(&event{Type: "event", Event: name, Body: body})
+}
+
+func (s *Server) sendOutput(category, output string) {
+	s.sendEvent("output", map[string]interface{}{"category": category, "output": output})
+}
+
+func (s *Server) send(message interface{}) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.seq++
+	switch m := message.(type) {
+	case *response:
+		m.Seq = s.seq
+	case *event:
+		m.Seq = s.seq
+	}
+	if err := writeMessage(s.writer, message); err != nil {
+		fmt.Fprintf(os.Stderr, "dap: %v\n", err)
+	}
+}
+
+// outputWriter forwards program output to the client as output events
+type outputWriter struct {
+	server   *Server
+	category string
+}
+
+func (w outputWriter) Write(p []byte) (int, error) {
+	w.server.sendOutput(w.category, string(p))
+	return len(p), nil
+}
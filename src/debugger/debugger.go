@@ -0,0 +1,282 @@
+package debugger
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"moji/src/evaluator"
+	"moji/src/parser"
+	"moji/src/scanner"
+)
+
+// errQuit stops the program when the user quits the debugger
+var errQuit = errors.New("debugger: quit")
+
+type stepMode int
+
+const (
+	modeStep     stepMode = iota // Pause at the next statement
+	modeNext                     // Pause at the next statement at the same depth or shallower
+	modeContinue                 // Pause only at breakpoints
+	modeDetached                 // Never pause again
+)
+
+// Debugger drives an evaluator one statement at a time from line commands
+type Debugger struct {
+	evaluator   *evaluator.Evaluator
+	lines       []string
+	breakpoints map[int]bool
+	mode        stepMode
+	stepDepth   int
+	lastCommand string
+	in          *bufio.Scanner
+	out         io.Writer
+}
+
+// NewDebugger creates a debugger for the evaluator running the given source
+func NewDebugger(e *evaluator.Evaluator, source string, in io.Reader, out io.Writer) *Debugger {
+	return &Debugger{
+		evaluator:   e,
+		lines:       strings.Split(source, "\n"),
+		breakpoints: make(map[int]bool),
+		mode:        modeStep,
+		in:          bufio.NewScanner(in),
+		out:         out,
+	}
+}
+
+// Run executes the program, pausing before the first statement
+func (d *Debugger) Run() error {
+	d.evaluator.SetHooks(&evaluator.Hooks{BeforeStatement: d.beforeStatement})
+	fmt.Fprintln(d.out, "Moji debugger. Type 'help' for a list of commands.")
+
+	err := d.evaluator.Execute()
+	if err == errQuit {
+		return nil
+	}
+	if err == evaluator.ErrSyntax {
+		return err
+	}
+	if err != nil {
+		fmt.Fprintln(d.out, err.Error())
+		return err
+	}
+	fmt.Fprintln(d.out, "Program finished.")
+	return nil
+}
+
+func (d *Debugger) beforeStatement(line int, stmt string, depth int) error {
+	// Blocks only group statements, pause at their contents instead
+	if strings.HasPrefix(stmt, "(block") {
+		return nil
+	}
+
+	switch d.mode {
+	case modeDetached:
+		return nil
+	case modeContinue:
+		if !d.breakpoints[line] {
+			return nil
+		}
+		fmt.Fprintf(d.out, "Breakpoint at line %d\n", line)
+	case modeNext:
+		if depth > d.stepDepth && !d.breakpoints[line] {
+			return nil
+		}
+	}
+
+	d.showLine(line)
+	return d.prompt(depth)
+}
+
+// prompt reads commands until one resumes execution
+func (d *Debugger) prompt(depth int) error {
+	for {
+		fmt.Fprint(d.out, "(moji) ")
+		if !d.in.Scan() {
+			// Input is exhausted, let the program run to completion
+			fmt.Fprintln(d.out)
+			d.mode = modeDetached
+			return nil
+		}
+
+		input := strings.TrimSpace(d.in.Text())
+		if input == "" {
+			input = d.lastCommand
+		}
+		d.lastCommand = input
+
+		command, arg, _ := strings.Cut(input, " ")
+		arg = strings.TrimSpace(arg)
+
+		switch command {
+		case "s", "step":
+			d.mode = modeStep
+			return nil
+		case "n", "next":
+			d.mode = modeNext
+			d.stepDepth = depth
+			return nil
+		case "c", "continue":
+			d.mode = modeContinue
+			return nil
+		case "b", "break":
+			d.setBreakpoint(arg, true)
+		case "d", "delete":
+			d.setBreakpoint(arg, false)
+		case "i", "info":
+			d.listBreakpoints()
+		case "p", "print":
+			d.printExpression(arg)
+		case "e", "env":
+			d.printEnvironment()
+		case "l", "list":
+			d.listSource(d.evaluator.Line())
+		case "q", "quit":
+			return errQuit
+		case "h", "help":
+			d.printHelp()
+		case "":
+		default:
+			fmt.Fprintf(d.out, "Unknown command '%s'. Type 'help' for a list of commands.\n", command)
+		}
+	}
+}
+
+func (d *Debugger) setBreakpoint(arg string, enabled bool) {
+	line, err := strconv.Atoi(arg)
+	if err != nil || line < 1 || line > len(d.lines) {
+		fmt.Fprintf(d.out, "Invalid line number '%s'.\n", arg)
+		return
+	}
+
+	if enabled {
+		d.breakpoints[line] = true
+		fmt.Fprintf(d.out, "Breakpoint set at line %d\n", line)
+	} else {
+		delete(d.breakpoints, line)
+		fmt.Fprintf(d.out, "Breakpoint removed from line %d\n", line)
+	}
+}
+
+func (d *Debugger) listBreakpoints() {
+	if len(d.breakpoints) == 0 {
+		fmt.Fprintln(d.out, "No breakpoints.")
+		return
+	}
+
+	lines := make([]int, 0, len(d.breakpoints))
+	for line := range d.breakpoints {
+		lines = append(lines, line)
+	}
+	sort.Ints(lines)
+	for _, line := range lines {
+		fmt.Fprintf(d.out, "Breakpoint at line %d\n", line)
+	}
+}
+
+// printExpression scans, parses and evaluates an expression in the current scope
+func (d *Debugger) printExpression(source string) {
+	if source == "" {
+		fmt.Fprintln(d.out, "Usage: print <expression>")
+		return
+	}
+
+	s := scanner.NewScanner(source)
+	tokens := s.ScanTokens()
+	if s.HasError() {
+		return
+	}
+	expr, ok := parser.NewParser(tokens).ParseExpression()
+	if !ok {
+		return
+	}
+
+	value, err := d.evaluator.Eval(expr)
+	if err != nil {
+		fmt.Fprintln(d.out, err.Error())
+		return
+	}
+	fmt.Fprintln(d.out, value)
+}
+
+// printEnvironment lists every scope from the innermost block out to the globals
+func (d *Debugger) printEnvironment() {
+	scopes := []*evaluator.Environment{}
+	for env := d.evaluator.Environment(); env != nil; env = env.Enclosing() {
+		scopes = append(scopes, env)
+	}
+
+	for i, env := range scopes {
+		if i == len(scopes)-1 {
+			fmt.Fprintln(d.out, "globals:")
+		} else {
+			fmt.Fprintf(d.out, "block %d:\n", len(scopes)-1-i)
+		}
+
+		names := env.Names()
+		if len(names) == 0 {
+			fmt.Fprintln(d.out, "  (empty)")
+		}
+		for _, name := range names {
+			value, _ := env.Get(name)
+			if env.IsConstant(name) {
+				fmt.Fprintf(d.out, "  🔒 %s = %s\n", name, value)
+			} else {
+				fmt.Fprintf(d.out, "  %s = %s\n", name, value)
+			}
+		}
+	}
+}
+
+func (d *Debugger) showLine(line int) {
+	fmt.Fprintf(d.out, "-> %d: %s\n", line, strings.TrimSpace(d.sourceLine(line)))
+}
+
+func (d *Debugger) listSource(current int) {
+	from := current - 3
+	if from < 1 {
+		from = 1
+	}
+	to := current + 3
+	if to > len(d.lines) {
+		to = len(d.lines)
+	}
+
+	for line := from; line <= to; line++ {
+		marker := "  "
+		if line == current {
+			marker = "->"
+		} else if d.breakpoints[line] {
+			marker = "* "
+		}
+		fmt.Fprintf(d.out, "%s %3d  %s\n", marker, line, d.sourceLine(line))
+	}
+}
+
+func (d *Debugger) sourceLine(line int) string {
+	if line < 1 || line > len(d.lines) {
+		return ""
+	}
+	return strings.TrimRight(d.lines[line-1], "\r")
+}
+
+func (d *Debugger) printHelp() {
+	fmt.Fprintln(d.out, `Commands:
+  s, step          run until the next statement, entering blocks and loops
+  n, next          run until the next statement at this depth or shallower
+  c, continue      run until the next breakpoint
+  b, break <line>  set a breakpoint
+  d, delete <line> remove a breakpoint
+  i, info          list breakpoints
+  p, print <expr>  evaluate an expression in the current scope
+  e, env           show variables in every scope up to the globals
+  l, list          show the source around the current line
+  q, quit          stop the program and exit
+An empty line repeats the previous command.`)
+}
@@ -1,93 +1,128 @@
 package evaluator
 
 import (
-	"fmt"
-	"os"
+	"math"
 	"strconv"
 	"strings"
 )
 
 func (e *Evaluator) evalMultiply(expr string) (string, error) {
-	// Extract the operands
-	left, right, ok := splitOperands(expr)
-	if !ok {
-		return expr, NewEvaluationError(ErrInvalidExpression, expr)
+	leftValue, rightValue, err := e.evalOperands(expr)
+	if err != nil {
+		return expr, err
 	}
 	
-	// Evaluate both operands recursively
-	leftValue, err := e.evaluateExpression(left)
+	result, err := multiplyValues(e.numeric, leftValue, rightValue)
 	if err != nil {
 		return expr, err
 	}
-	rightValue, err := e.evaluateExpression(right)
+	return result, nil
+}
+
+func multiplyValues(mode NumericMode, leftValue, rightValue string) (string, error) {
+	leftNum, rightNum, err := numberOperands(mode, leftValue, rightValue)
+	if err != nil {
+		return "", err
+	}
+	return multiplyNumbers(leftNum, rightNum).String(), nil
+}
+
+func (e *Evaluator) evalDivide(expr string) (string, error) {
+	leftValue, rightValue, err := e.evalOperands(expr)
 	if err != nil {
 		return expr, err
 	}
 	
-	// Check for booleans or nil, which are invalid for multiplication
-	if isBoolean(leftValue) || isBoolean(rightValue) || leftValue == "nil" || rightValue == "nil" {
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Operands must be numbers.", line)
+	result, err := divideValues(e.numeric, leftValue, rightValue)
+	if err != nil {
+		return expr, err
 	}
-	
-	// Convert to numbers and multiply
-	leftNum, err1 := strconv.ParseFloat(leftValue, 64)
-	rightNum, err2 := strconv.ParseFloat(rightValue, 64)
-	if err1 != nil || err2 != nil {
-		// The operands must be numbers - runtime error
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Operands must be numbers.", line)
+	return result, nil
+}
+
+func divideValues(mode NumericMode, leftValue, rightValue string) (string, error) {
+	leftNum, rightNum, err := numberOperands(mode, leftValue, rightValue)
+	if err != nil {
+		return "", err
 	}
-	result := leftNum * rightNum
-	// Format the result
-	if result == float64(int64(result)) {
-		return strconv.FormatInt(int64(result), 10), nil
+	if rightNum.isZero() {
+		// Division by zero, throw a runtime error
+		return "", NewRuntimeError("Division by zero.", 0)
 	}
-	return strconv.FormatFloat(result, 'f', -1, 64), nil
+	return divideNumbers(leftNum, rightNum).String(), nil
 }
 
-func (e *Evaluator) evalDivide(expr string) (string, error) {
-	// Extract the operands
-	left, right, ok := splitOperands(expr)
-	if !ok {
-		return expr, NewEvaluationError(ErrInvalidExpression, expr)
+func (e *Evaluator) evalModulo(expr string) (string, error) {
+	leftValue, rightValue, err := e.evalOperands(expr)
+	if err != nil {
+		return expr, err
 	}
-	
-	// Evaluate both operands recursively
-	leftValue, err := e.evaluateExpression(left)
+
+	result, err := moduloValues(e.numeric, leftValue, rightValue)
 	if err != nil {
 		return expr, err
 	}
-	rightValue, err := e.evaluateExpression(right)
+	return result, nil
+}
+
+func moduloValues(mode NumericMode, leftValue, rightValue string) (string, error) {
+	leftNum, rightNum, err := numberOperands(mode, leftValue, rightValue)
+	if err != nil {
+		return "", err
+	}
+	if rightNum.isZero() {
+		return "", NewRuntimeError("Modulo by zero.", 0)
+	}
+	return moduloNumbers(leftNum, rightNum).String(), nil
+}
+
+func (e *Evaluator) evalFloorDivide(expr string) (string, error) {
+	leftValue, rightValue, err := e.evalOperands(expr)
 	if err != nil {
 		return expr, err
 	}
-	
-	// Check for booleans or nil, which are invalid for division
-	if isBoolean(leftValue) || isBoolean(rightValue) || leftValue == "nil" || rightValue == "nil" {
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Operands must be numbers.", line)
+
+	result, err := floorDivideValues(e.numeric, leftValue, rightValue)
+	if err != nil {
+		return expr, err
 	}
-	
-	// Convert to numbers and divide
-	leftNum, err1 := strconv.ParseFloat(leftValue, 64)
-	rightNum, err2 := strconv.ParseFloat(rightValue, 64)
-	if err1 != nil || err2 != nil {
-		// The operands must be numbers - runtime error
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Operands must be numbers.", line)
+	return result, nil
+}
+
+func floorDivideValues(mode NumericMode, leftValue, rightValue string) (string, error) {
+	leftNum, rightNum, err := numberOperands(mode, leftValue, rightValue)
+	if err != nil {
+		return "", err
 	}
-	if rightNum == 0 {
-		// Division by zero, throw a runtime error
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Division by zero.", line)
+	if rightNum.isZero() {
+		return "", NewRuntimeError("Division by zero.", 0)
+	}
+	return floorDivideNumbers(leftNum, rightNum).String(), nil
+}
+
+func (e *Evaluator) evalPower(expr string) (string, error) {
+	leftValue, rightValue, err := e.evalOperands(expr)
+	if err != nil {
+		return expr, err
 	}
-	result := leftNum / rightNum
-	// Format the result
-	if result == float64(int64(result)) {
-		return strconv.FormatInt(int64(result), 10), nil
+
+	result, err := powerValues(e.numeric, leftValue, rightValue)
+	if err != nil {
+		return expr, err
 	}
-	return strconv.FormatFloat(result, 'f', -1, 64), nil
+	return result, nil
+}
+
+func powerValues(mode NumericMode, leftValue, rightValue string) (string, error) {
+	leftNum, rightNum, err := numberOperands(mode, leftValue, rightValue)
+	if err != nil {
+		return "", err
+	}
+	result, err := powerNumbers(leftNum, rightNum)
+	if err != nil {
+		return "", err
+	}
+	return result.String(), nil
 }
 
 func (e *Evaluator) evalAdd(expr string) (string, error) {
@@ -105,58 +140,13 @@ func (e *Evaluator) evalAdd(expr string) (string, error) {
 	if !ok {
 		return expr, NewEvaluationError(ErrInvalidExpression, expr)
 	}
-	//
+	
 	// Check for empty parentheses in operands
 	if left == "()" || right == "()" {
 		return "()", nil
 	}
 	
-	// Check if operands are explicitly marked as strings
-	leftIsString := strings.HasPrefix(left, "(string ") && strings.HasSuffix(left, ")")
-	rightIsString := strings.HasPrefix(right, "(string ") && strings.HasSuffix(right, ")")
-	
-	// Check for quoted string literals too
-	if !leftIsString {
-		leftIsString = strings.HasPrefix(strings.TrimSpace(left), "\"") && strings.HasSuffix(strings.TrimSpace(left), "\"")
-	}
-	if !rightIsString {
-		rightIsString = strings.HasPrefix(strings.TrimSpace(right), "\"") && strings.HasSuffix(strings.TrimSpace(right), "\"")
-	}
-	
-	// If both are string literals, do string concatenation
-	if leftIsString && rightIsString {
-		// Evaluate both operands recursively to get the string content
-		leftValue, err := e.evaluateExpression(left)
-		if err != nil {
-			return expr, err
-		}
-		rightValue, err := e.evaluateExpression(right)
-		if err != nil {
-			return expr, err
-		}
-		
-		// If the values are quoted strings, remove quotes before concatenation
-		if strings.HasPrefix(leftValue, "\"") && strings.HasSuffix(leftValue, "\"") {
-			leftValue = leftValue[1:len(leftValue)-1]
-		}
-		if strings.HasPrefix(rightValue, "\"") && strings.HasSuffix(rightValue, "\"") {
-			rightValue = rightValue[1:len(rightValue)-1]
-		}
-		
-		// Return the concatenated string with quotes
-		return "\"" + leftValue + rightValue + "\"", nil
-	}
-	
-	// If one is a string literal and one is a number literal, it's an error
-	leftIsNumber := isNumeric(left) && !leftIsString
-	rightIsNumber := isNumeric(right) && !rightIsString
-	
-	if (leftIsString && rightIsNumber) || (leftIsNumber && rightIsString) {
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Operands must be two numbers or two strings.", line)
-	}
-	
-	// For non-string literals, proceed with normal evaluation
+	// Evaluate both operands recursively
 	leftValue, err := e.evaluateExpression(left)
 	if err != nil {
 		return expr, err
@@ -166,6 +156,17 @@ func (e *Evaluator) evalAdd(expr string) (string, error) {
 		return expr, err
 	}
 	
+	result, err := addValues(e.numeric, leftValue, rightValue)
+	if err != nil {
+		return expr, err
+	}
+	if err := e.checkSize(result); err != nil {
+		return expr, err
+	}
+	return result, nil
+}
+
+func addValues(mode NumericMode, leftValue, rightValue string) (string, error) {
 	// Special handling for empty parentheses results
 	if leftValue == "()" || rightValue == "()" {
 		return "()", nil
@@ -173,51 +174,39 @@ func (e *Evaluator) evalAdd(expr string) (string, error) {
 	
 	// Check for booleans or nil, which are invalid for addition
 	if isBoolean(leftValue) || isBoolean(rightValue) || leftValue == "nil" || rightValue == "nil" {
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Operands must be two numbers or two strings.", line)
+		return "", NewRuntimeError("Operands must be two numbers or two strings.", 0)
+	}
+	
+	// Lists and maps don't concatenate; append builds a longer list
+	if isList(leftValue) || isList(rightValue) || isMap(leftValue) || isMap(rightValue) {
+		return "", NewRuntimeError("Operands must be two numbers or two strings.", 0)
 	}
 	
 	// Check if both values are numeric for addition
-	leftIsNumberValue := isNumeric(leftValue)
-	rightIsNumberValue := isNumeric(rightValue)
+	leftNum, leftIsNumberValue := parseNumber(mode, leftValue)
+	rightNum, rightIsNumberValue := parseNumber(mode, rightValue)
 	
 	// If both are numbers, perform numeric addition
 	if leftIsNumberValue && rightIsNumberValue {
-		leftNum, _ := strconv.ParseFloat(leftValue, 64)
-		rightNum, _ := strconv.ParseFloat(rightValue, 64)
-		result := leftNum + rightNum
-		// Format the result
-		if result == float64(int64(result)) {
-			return strconv.FormatInt(int64(result), 10), nil
-		}
-		return strconv.FormatFloat(result, 'f', -1, 64), nil
+		return addNumbers(leftNum, rightNum).String(), nil
 	}
 	
 	// If neither is a number, treat as string concatenation
 	if !leftIsNumberValue && !rightIsNumberValue {
 		// If the values are quoted strings, remove quotes before concatenation
-		if strings.HasPrefix(leftValue, "\"") && strings.HasSuffix(leftValue, "\"") {
-			leftValue = leftValue[1:len(leftValue)-1]
-		}
-		if strings.HasPrefix(rightValue, "\"") && strings.HasSuffix(rightValue, "\"") {
-			rightValue = rightValue[1:len(rightValue)-1]
-		}
-		
-		// Return the concatenated string with quotes
-		return "\"" + leftValue + rightValue + "\"", nil
+		return "\"" + unquote(leftValue) + unquote(rightValue) + "\"", nil
 	}
 	
 	// If one is a number and one is a string, it's a mixed type error
-	line := 1 // Default to line 1
-	return expr, NewRuntimeError("Operands must be two numbers or two strings.", line)
+	return "", NewRuntimeError("Operands must be two numbers or two strings.", 0)
 }
 
 // isNumeric checks if a value is a numeric value
 // Returns true if the value is a number, false otherwise
 func isNumeric(value string) bool {
-	// Try to parse as a number
-	_, err := strconv.ParseFloat(value, 64)
-	return err == nil
+	// Float mode accepts every number decimal mode does
+	_, ok := parseNumber(NumericFloat, value)
+	return ok
 }
 
 // Helper function to check if a value is a boolean
@@ -225,43 +214,77 @@ func isBoolean(value string) bool {
 	return value == "true" || value == "false"
 }
 
-func (e *Evaluator) evalSubtract(expr string) (string, error) {
+// unquote removes the quotes around a string value
+func unquote(value string) string {
+	if len(value) >= 2 && strings.HasPrefix(value, "\"") && strings.HasSuffix(value, "\"") {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// formatNumber formats a number without trailing zeros
+func formatNumber(result float64) string {
+	if math.Abs(result) < 1<<63 && result == float64(int64(result)) {
+		return strconv.FormatInt(int64(result), 10)
+	}
+	return strconv.FormatFloat(result, 'f', -1, 64)
+}
+
+// numberOperands converts both operands of an arithmetic operator to numbers
+func numberOperands(mode NumericMode, leftValue, rightValue string) (number, number, error) {
+	// Check for booleans or nil, which are invalid for arithmetic
+	if isBoolean(leftValue) || isBoolean(rightValue) || leftValue == "nil" || rightValue == "nil" {
+		return number{}, number{}, NewRuntimeError("Operands must be numbers.", 0)
+	}
+	
+	leftNum, ok1 := parseNumber(mode, leftValue)
+	rightNum, ok2 := parseNumber(mode, rightValue)
+	if !ok1 || !ok2 {
+		// The operands must be numbers - runtime error
+		return number{}, number{}, NewRuntimeError("Operands must be numbers.", 0)
+	}
+	return leftNum, rightNum, nil
+}
+
+// evalOperands splits a binary expression and evaluates both operands
+func (e *Evaluator) evalOperands(expr string) (string, string, error) {
 	// Extract the operands
 	left, right, ok := splitOperands(expr)
 	if !ok {
-		return expr, NewEvaluationError(ErrInvalidExpression, expr)
+		return "", "", NewEvaluationError(ErrInvalidExpression, expr)
 	}
 	
 	// Evaluate both operands recursively
 	leftValue, err := e.evaluateExpression(left)
 	if err != nil {
-		return expr, err
+		return "", "", err
 	}
 	rightValue, err := e.evaluateExpression(right)
 	if err != nil {
-		return expr, err
+		return "", "", err
 	}
-	
-	// Check for booleans or nil, which are invalid for subtraction
-	if isBoolean(leftValue) || isBoolean(rightValue) || leftValue == "nil" || rightValue == "nil" {
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Operands must be numbers.", line)
+	return leftValue, rightValue, nil
+}
+
+func (e *Evaluator) evalSubtract(expr string) (string, error) {
+	leftValue, rightValue, err := e.evalOperands(expr)
+	if err != nil {
+		return expr, err
 	}
 	
-	// Convert to numbers and subtract
-	leftNum, err1 := strconv.ParseFloat(leftValue, 64)
-	rightNum, err2 := strconv.ParseFloat(rightValue, 64)
-	if err1 != nil || err2 != nil {
-		// The operands must be numbers - runtime error
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Operands must be numbers.", line)
+	result, err := subtractValues(e.numeric, leftValue, rightValue)
+	if err != nil {
+		return expr, err
 	}
-	result := leftNum - rightNum
-	// Format the result
-	if result == float64(int64(result)) {
-		return strconv.FormatInt(int64(result), 10), nil
+	return result, nil
+}
+
+func subtractValues(mode NumericMode, leftValue, rightValue string) (string, error) {
+	leftNum, rightNum, err := numberOperands(mode, leftValue, rightValue)
+	if err != nil {
+		return "", err
 	}
-	return strconv.FormatFloat(result, 'f', -1, 64), nil
+	return subtractNumbers(leftNum, rightNum).String(), nil
 }
 
 func (e *Evaluator) evalUnaryMinus(expr string) (string, error) {
@@ -269,28 +292,26 @@ func (e *Evaluator) evalUnaryMinus(expr string) (string, error) {
 	operand := strings.TrimPrefix(expr, "(- ")
 	operand = strings.TrimSuffix(operand, ")")
 	
-	// Find the token associated with this expression to get the line number
-	line := 1 // Default to line 1 if we can't find the token info
-	
 	// Evaluate the operand recursively
 	value, err := e.evaluateExpression(operand)
 	if err != nil {
 		return expr, err
 	}
 	
-	// Convert to number and negate
-	num, err := strconv.ParseFloat(value, 64)
+	result, err := negateValue(e.numeric, value)
 	if err != nil {
-		// The operand is not a number, throw a runtime error
-		fmt.Fprintf(os.Stderr, "Runtime error: operand %q is not a number\n", value)
+		return expr, err
+	}
+	return result, nil
+}
+
+func negateValue(mode NumericMode, value string) (string, error) {
+	// Convert to number and negate
+	num, ok := parseNumber(mode, value)
+	if !ok {
 		// Use exact message "Operand must be a number." as per the specification
-		return expr, NewRuntimeError("Operand must be a number.", line)
+		return "", NewRuntimeError("Operand must be a number.", 0)
 	}
 	
-	result := -num
-	// Format the result
-	if result == float64(int64(result)) {
-		return strconv.FormatInt(int64(result), 10), nil
-	}
-	return strconv.FormatFloat(result, 'f', -1, 64), nil
+	return negateNumber(num).String(), nil
 }
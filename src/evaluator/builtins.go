@@ -0,0 +1,209 @@
+package evaluator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// builtin is a function scripts can call by name. Arguments arrive
+// evaluated, and errors are runtime errors without a line.
+type builtin struct {
+	minArgs  int
+	maxArgs  int // -1 for any number
+	function func(args []string) (string, error)
+}
+
+// limitedFunction is called instead of a builtin's function, with the
+// running program's limits, by builtins whose results can grow large
+// enough that the string length limit must be checked before building them
+type limitedFunction func(args []string, limits *LimitTracker) (string, error)
+
+var limitedBuiltins = map[string]limitedFunction{}
+
+// numericFunction is called instead of a builtin's function, with the
+// running program's numeric mode, by builtins that look up map keys
+type numericFunction func(args []string, mode NumericMode) (string, error)
+
+var numericBuiltins = map[string]numericFunction{
+	"remove": builtinRemove,
+	"🗑️":     builtinRemove,
+	"has":    builtinHas,
+}
+
+// builtins maps the names scripts call, including their emoji spellings,
+// to the functions
+var builtins = map[string]builtin{
+	"len":    {1, 1, builtinLen},
+	"📏":      {1, 1, builtinLen},
+	"append": {1, -1, builtinAppend},
+	"📎":      {1, -1, builtinAppend},
+	"remove": {2, 2, nil},
+	"🗑️":     {2, 2, nil},
+	"has":    {2, 2, nil},
+	"keys":   {1, 1, builtinKeys},
+	"values": {1, 1, builtinValues},
+}
+
+// CallBuiltin calls the built-in function with the given name from a call
+// on line, reporting errors on that line. Map keys compare in mode, and
+// limits, which may be nil, bounds the length of the value it returns.
+func CallBuiltin(name string, args []string, line int, mode NumericMode, limits *LimitTracker) (string, error) {
+	result, err := callBuiltin(name, args, mode, limits)
+	if err == nil && limits != nil {
+		err = limits.Value(result, line)
+	}
+	switch err := err.(type) {
+	case *RuntimeError:
+		if err.Line == 0 {
+			err.Line = line
+		}
+	case *LimitError:
+		if err.Line == 0 {
+			err.Line = line
+		}
+	}
+	return result, err
+}
+
+func callBuiltin(name string, args []string, mode NumericMode, limits *LimitTracker) (string, error) {
+	fn, ok := builtins[name]
+	if !ok {
+		return "", NewRuntimeError(fmt.Sprintf("Undefined function '%s'.", name), 0)
+	}
+	switch {
+	case fn.minArgs == fn.maxArgs && len(args) != fn.minArgs:
+		return "", NewRuntimeError(fmt.Sprintf("Expected %d %s but got %d.", fn.minArgs, plural(fn.minArgs, "argument"), len(args)), 0)
+	case fn.maxArgs == -1 && len(args) < fn.minArgs:
+		return "", NewRuntimeError(fmt.Sprintf("Expected at least %d %s but got %d.", fn.minArgs, plural(fn.minArgs, "argument"), len(args)), 0)
+	case len(args) < fn.minArgs || (fn.maxArgs != -1 && len(args) > fn.maxArgs):
+		return "", NewRuntimeError(fmt.Sprintf("Expected %d to %d arguments but got %d.", fn.minArgs, fn.maxArgs, len(args)), 0)
+	}
+	if limited, ok := limitedBuiltins[name]; ok {
+		return limited(args, limits)
+	}
+	if numeric, ok := numericBuiltins[name]; ok {
+		return numeric(args, mode)
+	}
+	return fn.function(args)
+}
+
+func plural(count int, word string) string {
+	if count == 1 {
+		return word
+	}
+	return word + "s"
+}
+
+// len(xs) is the number of elements in a list, entries in a map or
+// grapheme clusters in a string
+func builtinLen(args []string) (string, error) {
+	if entries, ok := parseMap(args[0]); ok {
+		return strconv.Itoa(len(entries)), nil
+	}
+	if isString(args[0]) {
+		return strconv.Itoa(len(graphemes(unquote(args[0])))), nil
+	}
+	elements, ok := parseList(args[0])
+	if !ok {
+		return "", NewRuntimeError("Argument to len must be a list, map or string.", 0)
+	}
+	return strconv.Itoa(len(elements)), nil
+}
+
+// append(xs, values...) is xs with the values added at the end
+func builtinAppend(args []string) (string, error) {
+	elements, ok := parseList(args[0])
+	if !ok {
+		return "", NewRuntimeError("First argument to append must be a list.", 0)
+	}
+	return formatList(append(elements, args[1:]...)), nil
+}
+
+// remove(xs, i) is xs without the element at index i, and remove(m, key)
+// is m without the key, if it has it
+func builtinRemove(args []string, mode NumericMode) (string, error) {
+	if entries, ok := parseMap(args[0]); ok {
+		if err := checkKey(args[1]); err != nil {
+			return "", err
+		}
+		if i := findKey(mode, entries, args[1]); i != -1 {
+			entries = append(entries[:i], entries[i+1:]...)
+		}
+		return formatMap(entries), nil
+	}
+
+	elements, ok := parseList(args[0])
+	if !ok {
+		return "", NewRuntimeError("First argument to remove must be a list or map.", 0)
+	}
+	i, err := elementIndex("List", args[1], len(elements))
+	if err != nil {
+		return "", err
+	}
+	return formatList(append(elements[:i], elements[i+1:]...)), nil
+}
+
+// has(m, key) reports whether a map has the key
+func builtinHas(args []string, mode NumericMode) (string, error) {
+	entries, ok := parseMap(args[0])
+	if !ok {
+		return "", NewRuntimeError("First argument to has must be a map.", 0)
+	}
+	if err := checkKey(args[1]); err != nil {
+		return "", err
+	}
+	return strconv.FormatBool(findKey(mode, entries, args[1]) != -1), nil
+}
+
+// keys(m) lists the keys of a map in insertion order
+func builtinKeys(args []string) (string, error) {
+	entries, ok := parseMap(args[0])
+	if !ok {
+		return "", NewRuntimeError("Argument to keys must be a map.", 0)
+	}
+	keys := make([]string, len(entries))
+	for i, entry := range entries {
+		keys[i] = entry.key
+	}
+	return formatList(keys), nil
+}
+
+// values(m) lists the values of a map in insertion order
+func builtinValues(args []string) (string, error) {
+	entries, ok := parseMap(args[0])
+	if !ok {
+		return "", NewRuntimeError("Argument to values must be a map.", 0)
+	}
+	values := make([]string, len(entries))
+	for i, entry := range entries {
+		values[i] = entry.value
+	}
+	return formatList(values), nil
+}
+
+// Evaluate (call <name> <line> <argument>...)
+func (e *Evaluator) evalCall(expr string) (string, error) {
+	content := strings.TrimPrefix(expr, "(call ")
+	content = strings.TrimSuffix(content, ")")
+
+	parts := splitExpressions(content)
+	if len(parts) < 2 {
+		return "", NewEvaluationError(ErrInvalidExpression, expr)
+	}
+	line, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", NewEvaluationError(ErrInvalidExpression, expr)
+	}
+
+	args := make([]string, 0, len(parts)-2)
+	for _, part := range parts[2:] {
+		value, err := e.evaluateExpression(part)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, value)
+	}
+
+	return CallBuiltin(parts[0], args, line, e.numeric, e.limits)
+}
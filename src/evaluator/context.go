@@ -0,0 +1,62 @@
+package evaluator
+
+import (
+	"context"
+	"fmt"
+)
+
+// CancelledError is returned when the context passed to ExecuteContext is
+// cancelled or its deadline passes while the program is running
+type CancelledError struct {
+	Err  error // The context's error
+	Line int   // Line of the statement that was executing
+}
+
+func (e *CancelledError) Error() string {
+	return fmt.Sprintf("Execution cancelled: %v\n[line %d]", e.Err, e.Line)
+}
+
+// Unwrap lets callers test for context.Canceled or context.DeadlineExceeded
+func (e *CancelledError) Unwrap() error {
+	return e.Err
+}
+
+// ExecuteContext is like Execute, but stops with a CancelledError when ctx
+// is done. The context is checked before every statement and every loop
+// iteration.
+func (e *Evaluator) ExecuteContext(ctx context.Context) error {
+	e.ctx = ctx
+	defer func() { e.ctx = nil }()
+	return e.Execute()
+}
+
+// ExecuteStatementsContext is like ExecuteStatements, but stops with a
+// CancelledError when ctx is done
+func (e *Evaluator) ExecuteStatementsContext(ctx context.Context, statements []string) error {
+	e.ctx = ctx
+	defer func() { e.ctx = nil }()
+	return e.ExecuteStatements(statements)
+}
+
+// checkContext reports whether the running program has been cancelled
+func (e *Evaluator) checkContext() error {
+	if e.ctx == nil {
+		return nil
+	}
+	return CheckContext(e.ctx, e.line)
+}
+
+// CheckContext returns a CancelledError for the given line if ctx is done.
+// It is shared by the evaluator and the VM.
+func CheckContext(ctx context.Context, line int) error {
+	done := ctx.Done()
+	if done == nil {
+		return nil
+	}
+	select {
+	case <-done:
+		return &CancelledError{Err: ctx.Err(), Line: line}
+	default:
+		return nil
+	}
+}
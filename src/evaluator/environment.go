@@ -2,12 +2,18 @@ package evaluator
 
 import (
 	"fmt"
+	"sort"
 )
 
-// Environment stores variable bindings
+// Environment stores variable bindings. Globals are kept in a map; block
+// environments keep their variables in slots assigned by the resolver.
 type Environment struct {
-	values    map[string]string
-	enclosing *Environment // Reference to the enclosing environment
+	values    map[string]string // Globals, nil for block environments
+	constants map[string]bool   // Globals declared with 🔒
+	names     []string          // Name of the variable in each slot
+	slots     []string          // Value in each slot, "" until it is defined
+	constant  []bool            // Whether each slot holds a constant
+	enclosing *Environment      // Reference to the enclosing environment
 }
 
 // NewEnvironment creates a new environment
@@ -21,20 +27,73 @@ func NewEnvironment() *Environment {
 // NewLocalEnvironment creates a new environment with the given enclosing environment
 func NewLocalEnvironment(enclosing *Environment) *Environment {
 	return &Environment{
-		values:    make(map[string]string),
 		enclosing: enclosing,
 	}
 }
 
 // Define defines a new variable in the environment
 func (e *Environment) Define(name string, value string) {
-	e.values[name] = value
+	e.define(name, value, false)
+}
+
+// DefineConstant defines a variable that can't be assigned to
+func (e *Environment) DefineConstant(name string, value string) {
+	e.define(name, value, true)
+}
+
+func (e *Environment) define(name string, value string, constant bool) {
+	if e.values != nil {
+		e.values[name] = value
+		if constant {
+			if e.constants == nil {
+				e.constants = make(map[string]bool)
+			}
+			e.constants[name] = true
+		} else {
+			delete(e.constants, name)
+		}
+		return
+	}
+	if slot := e.slot(name); slot >= 0 {
+		e.slots[slot] = value
+		e.constant[slot] = constant
+		return
+	}
+	e.names = append(e.names, name)
+	e.slots = append(e.slots, value)
+	e.constant = append(e.constant, constant)
+}
+
+// DefineSlot defines a variable in the slot the resolver assigned to it
+func (e *Environment) DefineSlot(slot int, name string, value string) {
+	e.defineSlot(slot, name, value, false)
+}
+
+// DefineConstantSlot defines a constant in the slot the resolver assigned
+// to it
+func (e *Environment) DefineConstantSlot(slot int, name string, value string) {
+	e.defineSlot(slot, name, value, true)
+}
+
+func (e *Environment) defineSlot(slot int, name string, value string, constant bool) {
+	for len(e.slots) <= slot {
+		e.names = append(e.names, "")
+		e.slots = append(e.slots, "")
+		e.constant = append(e.constant, false)
+	}
+	e.names[slot] = name
+	e.slots[slot] = value
+	e.constant[slot] = constant
 }
 
 // Get retrieves a variable's value from the environment
 func (e *Environment) Get(name string) (string, error) {
-	if value, ok := e.values[name]; ok {
-		return value, nil
+	if e.values != nil {
+		if value, ok := e.values[name]; ok {
+			return value, nil
+		}
+	} else if slot := e.slot(name); slot >= 0 {
+		return e.slots[slot], nil
 	}
 	
 	// If the variable isn't found in this environment, check the enclosing one
@@ -45,11 +104,36 @@ func (e *Environment) Get(name string) (string, error) {
 	return "", NewRuntimeError(fmt.Sprintf("Undefined variable '%s'.", name), 1)
 }
 
+// GetSlot retrieves a resolved variable, depth environments up from this one
+func (e *Environment) GetSlot(depth int, slot int, name string) (string, error) {
+	env := e.ancestor(depth)
+	if slot < len(env.slots) && env.slots[slot] != "" {
+		return env.slots[slot], nil
+	}
+
+	// Not defined yet, so it can only refer to an outer variable
+	if env.enclosing != nil {
+		return env.enclosing.Get(name)
+	}
+	return "", NewRuntimeError(fmt.Sprintf("Undefined variable '%s'.", name), 1)
+}
+
 // Assign assigns a value to an existing variable
 func (e *Environment) Assign(name string, value string, line int) (string, error) {
 	// Check if the variable exists in this environment
-	if _, ok := e.values[name]; ok {
-		e.values[name] = value
+	if e.values != nil {
+		if _, ok := e.values[name]; ok {
+			if e.constants[name] {
+				return "", constantError(name, line)
+			}
+			e.values[name] = value
+			return value, nil
+		}
+	} else if slot := e.slot(name); slot >= 0 {
+		if e.constant[slot] {
+			return "", constantError(name, line)
+		}
+		e.slots[slot] = value
 		return value, nil
 	}
 	
@@ -59,4 +143,74 @@ func (e *Environment) Assign(name string, value string, line int) (string, error
 	}
 	
 	return "", NewRuntimeError(fmt.Sprintf("Undefined variable '%s'.", name), line)
-} 
\ No newline at end of file
+}
+
+// AssignSlot assigns to a resolved variable, depth environments up from this one
+func (e *Environment) AssignSlot(depth int, slot int, name string, value string, line int) (string, error) {
+	env := e.ancestor(depth)
+	if slot < len(env.slots) && env.slots[slot] != "" {
+		if env.constant[slot] {
+			return "", constantError(name, line)
+		}
+		env.slots[slot] = value
+		return value, nil
+	}
+
+	// Not defined yet, so it can only refer to an outer variable
+	if env.enclosing != nil {
+		return env.enclosing.Assign(name, value, line)
+	}
+	return "", NewRuntimeError(fmt.Sprintf("Undefined variable '%s'.", name), line)
+}
+
+func constantError(name string, line int) error {
+	return NewRuntimeError(fmt.Sprintf("Can't assign to constant '%s'.", name), line)
+}
+
+// IsConstant reports whether a variable defined directly in this
+// environment is a constant
+func (e *Environment) IsConstant(name string) bool {
+	if e.values != nil {
+		return e.constants[name]
+	}
+	slot := e.slot(name)
+	return slot >= 0 && e.constant[slot]
+}
+
+// Enclosing returns the enclosing environment, or nil for the globals
+func (e *Environment) Enclosing() *Environment {
+	return e.enclosing
+}
+
+// Names returns the variables defined directly in this environment, sorted
+func (e *Environment) Names() []string {
+	names := make([]string, 0, len(e.values)+len(e.names))
+	for name := range e.values {
+		names = append(names, name)
+	}
+	for slot, name := range e.names {
+		if e.slots[slot] != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// slot finds the slot holding a defined variable, or -1
+func (e *Environment) slot(name string) int {
+	for slot, slotName := range e.names {
+		if slotName == name && e.slots[slot] != "" {
+			return slot
+		}
+	}
+	return -1
+}
+
+func (e *Environment) ancestor(depth int) *Environment {
+	env := e
+	for i := 0; i < depth; i++ {
+		env = env.enclosing
+	}
+	return env
+}
@@ -0,0 +1,54 @@
+package evaluator
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Block scopes nested as deep as a function body inside a loop inside a
+// function, each holding a few variables
+const (
+	benchmarkDepth     = 4
+	benchmarkVariables = 4
+)
+
+// BenchmarkSlotLookup reads a variable from the outermost block through the
+// slot the resolver assigned to it
+func BenchmarkSlotLookup(b *testing.B) {
+	env := NewEnvironment()
+	for depth := 0; depth < benchmarkDepth; depth++ {
+		env = NewLocalEnvironment(env)
+		for slot := 0; slot < benchmarkVariables; slot++ {
+			env.DefineSlot(slot, fmt.Sprintf("v%d_%d", depth, slot), "1.0")
+		}
+	}
+	name := fmt.Sprintf("v0_%d", benchmarkVariables-1)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := env.GetSlot(benchmarkDepth-1, benchmarkVariables-1, name); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkMapChainLookup reads the same variable by name from a chain of
+// map-backed environments, the way every block stored its variables
+// before the resolver assigned slots
+func BenchmarkMapChainLookup(b *testing.B) {
+	env := NewEnvironment()
+	for depth := 0; depth < benchmarkDepth; depth++ {
+		env = &Environment{values: make(map[string]string), enclosing: env}
+		for slot := 0; slot < benchmarkVariables; slot++ {
+			env.Define(fmt.Sprintf("v%d_%d", depth, slot), "1.0")
+		}
+	}
+	name := fmt.Sprintf("v0_%d", benchmarkVariables-1)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := env.Get(name); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
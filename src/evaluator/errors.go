@@ -1,6 +1,7 @@
 package evaluator
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -9,6 +10,18 @@ const (
 	ErrInvalidExpression = "invalid expression format"
 )
 
+// ErrSyntax is returned by Execute when the program fails to parse.
+// The parser has already reported the details to stderr.
+var ErrSyntax = errors.New("syntax error")
+
+// errBreak and errContinue carry ⏹️ and ⏭️ out of the statements of a loop
+// body, unwinding blocks like any other error, until the loop catches them.
+// The parser rejects both outside of loops.
+var (
+	errBreak    = errors.New("break outside of a loop")
+	errContinue = errors.New("continue outside of a loop")
+)
+
 // EvaluationError represents an error during expression evaluation
 type EvaluationError struct {
 	Type string
@@ -31,6 +44,7 @@ func (e *EvaluationError) Error() string {
 type RuntimeError struct {
 	Message string
 	Line    int
+	Value   string // The value a 🚨 statement threw, "" for built-in errors
 }
 
 // NewRuntimeError creates a new RuntimeError
@@ -45,6 +59,25 @@ func (e *RuntimeError) Error() string {
 	return fmt.Sprintf("%s\n[line %d]", e.Message, e.Line)
 }
 
+// AssertionError is raised when an assert statement fails. It is reported
+// like a runtime error but lets the test runner tell failures from errors.
+type AssertionError struct {
+	Message string
+	Line    int
+}
+
+// NewAssertionError creates a new AssertionError
+func NewAssertionError(message string, line int) *AssertionError {
+	return &AssertionError{
+		Message: message,
+		Line:    line,
+	}
+}
+
+func (e *AssertionError) Error() string {
+	return fmt.Sprintf("%s\n[line %d]", e.Message, e.Line)
+}
+
 // GetFormattedMessage returns the message in the expected format
 func (e *RuntimeError) GetFormattedMessage() string {
 	return e.Message + "\n[line " + fmt.Sprint(e.Line) + "]"
@@ -1,25 +1,46 @@
 package evaluator
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
 	"moji/src/parser"
+	"moji/src/resolver"
+	"moji/src/sexpr"
 )
 
 type Evaluator struct {
 	parser *parser.Parser
 	environment *Environment
+	hooks *Hooks
+	out io.Writer
+	line int  // Line of the statement currently executing
+	depth int // Number of line-marked statements currently executing
+	limits *LimitTracker
+	ctx context.Context // Set while running under ExecuteContext
+	blocks map[string][]string // Statements of each block already split, for loop bodies
+	exports []string // Names declared with 📤, in order
+	importer *Importer
+	numeric NumericMode
 }
 
 func NewEvaluator(p *parser.Parser) *Evaluator {
 	return &Evaluator{
 		parser: p,
 		environment: NewEnvironment(),
+		out: os.Stdout,
+		importer: NewImporter(NewModuleLoader(nil), ""),
 	}
 }
 
+// SetOutput redirects the output of print statements, which defaults to stdout
+func (e *Evaluator) SetOutput(w io.Writer) {
+	e.out = w
+}
+
 // Evaluate a single expression
 func (e *Evaluator) Evaluate() string {
 	expr := e.parser.Parse()
@@ -54,32 +75,107 @@ func (e *Evaluator) Evaluate() string {
 
 // Evaluate a list of statements
 func (e *Evaluator) EvaluateStatements() {
-	statements := e.parser.ParseStatements()
-	
-	for _, stmt := range statements {
+	ExitOnError(e.Execute())
+}
+
+// ExitOnError reports an error returned by Execute and exits with the
+// matching status code. It does nothing if err is nil.
+func ExitOnError(err error) {
+	if err == ErrSyntax {
+		os.Exit(65)
+	}
+	if err != nil {
+		// Check if this is a runtime error
+		if runtimeErr, ok := err.(*RuntimeError); ok {
+			// For runtime errors, print the error message and exit with code 70
+			fmt.Println(runtimeErr.Error())
+			os.Exit(70)
+		}
+
+		// Failed assertions are reported the same way
+		if assertionErr, ok := err.(*AssertionError); ok {
+			fmt.Println(assertionErr.Error())
+			os.Exit(70)
+		}
+
+		// Cancellation comes from an interrupt, so exit like one
+		if cancelledErr, ok := err.(*CancelledError); ok {
+			fmt.Println(cancelledErr.Error())
+			os.Exit(130)
+		}
+
+		// Exceeded limits get their own exit code so callers can tell them apart
+		if limitErr, ok := err.(*LimitError); ok {
+			fmt.Println(limitErr.Error())
+			os.Exit(LimitExitCode)
+		}
+
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(70)
+	}
+}
+
+// Execute parses and runs the program, returning the error that stopped it
+// instead of exiting the process
+func (e *Evaluator) Execute() error {
+	e.parser.EnableLineMarkers()
+	statements, ok := e.parser.TryParseStatements()
+	if !ok {
+		return ErrSyntax
+	}
+
+	return e.ExecuteStatements(statements)
+}
+
+// ExecuteStatements runs statements that were already parsed, for tools that
+// need to inspect the program before it executes
+func (e *Evaluator) ExecuteStatements(statements []string) error {
+	for _, stmt := range resolver.Resolve(statements) {
 		err := e.executeStatement(stmt)
 		if err != nil {
-			// Check if this is a runtime error
-			if runtimeErr, ok := err.(*RuntimeError); ok {
-				// For runtime errors, print the error message and exit with code 70
-				fmt.Println(runtimeErr.Error())
-				os.Exit(70)
+			// Evaluation errors are only logged to stderr, execution continues
+			if _, ok := err.(*EvaluationError); ok {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				continue
 			}
-			
-			// For other types of errors, just log to stderr
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return err
 		}
 	}
+
+	return nil
 }
 
 // Execute a single statement with error handling
 func (e *Evaluator) executeStatement(stmt string) error {
+	// If it carries a line marker, record the line and run the inner statement
+	if strings.HasPrefix(stmt, "(at ") && strings.HasSuffix(stmt, ")") {
+		return e.executeMarkedStatement(stmt)
+	}
+
+	if err := e.checkContext(); err != nil {
+		return err
+	}
+	if e.limits != nil {
+		if err := e.limits.Statement(e.line); err != nil {
+			return err
+		}
+	}
+
 	// If it's a print statement, evaluate it and print the result
 	if strings.HasPrefix(stmt, "(print ") && strings.HasSuffix(stmt, ")") {
 		return e.executePrintStatement(stmt)
 	} else if strings.HasPrefix(stmt, "(var ") && strings.HasSuffix(stmt, ")") {
 		// Handle var declarations
 		return e.evaluateVarStatement(stmt)
+	} else if strings.HasPrefix(stmt, "(const ") && strings.HasSuffix(stmt, ")") {
+		// Handle constant declarations
+		return e.evaluateVarStatement(stmt)
+	} else if strings.HasPrefix(stmt, "(local-var ") && strings.HasSuffix(stmt, ")") {
+		// Handle var declarations inside blocks
+		return e.evaluateLocalVarStatement(stmt)
+	} else if strings.HasPrefix(stmt, "(local-const ") && strings.HasSuffix(stmt, ")") {
+		// Handle constant declarations inside blocks
+		return e.evaluateLocalVarStatement(stmt)
 	} else if strings.HasPrefix(stmt, "(block") && strings.HasSuffix(stmt, ")") {
 		// Handle block statements
 		return e.executeBlockStatement(stmt)
@@ -89,6 +185,34 @@ func (e *Evaluator) executeStatement(stmt string) error {
 	} else if strings.HasPrefix(stmt, "(while ") && strings.HasSuffix(stmt, ")") {
 		// Handle while statements
 		return e.executeWhileStatement(stmt)
+	} else if strings.HasPrefix(stmt, "(for-each ") && strings.HasSuffix(stmt, ")") {
+		// Handle for-each loops
+		return e.executeForEachStatement(stmt)
+	} else if strings.HasPrefix(stmt, "(match ") && strings.HasSuffix(stmt, ")") {
+		// Handle match statements
+		return e.executeMatchStatement(stmt)
+	} else if strings.HasPrefix(stmt, "(import ") && strings.HasSuffix(stmt, ")") {
+		// Handle imports
+		return e.executeImportStatement(stmt)
+	} else if strings.HasPrefix(stmt, "(export ") && strings.HasSuffix(stmt, ")") {
+		// Handle exported declarations
+		return e.executeExportStatement(stmt)
+	} else if strings.HasPrefix(stmt, "(try ") && strings.HasSuffix(stmt, ")") {
+		// Handle try statements
+		return e.executeTryStatement(stmt)
+	} else if strings.HasPrefix(stmt, "(throw ") && strings.HasSuffix(stmt, ")") {
+		// Handle throw statements
+		return e.executeThrowStatement(stmt)
+	} else if stmt == "(break)" {
+		return errBreak
+	} else if stmt == "(continue)" {
+		return errContinue
+	} else if strings.HasPrefix(stmt, "(test ") && strings.HasSuffix(stmt, ")") {
+		// Tests only run under the test runner
+		return nil
+	} else if strings.HasPrefix(stmt, "(assert ") && strings.HasSuffix(stmt, ")") {
+		// Handle assertions
+		return e.executeAssertStatement(stmt)
 	} else {
 		// For regular expression statements, evaluate but don't print
 		_, err := e.evaluateExpression(stmt)
@@ -96,6 +220,44 @@ func (e *Evaluator) executeStatement(stmt string) error {
 	}
 }
 
+// Execute a statement wrapped as (at <line> <stmt>) by the parser
+func (e *Evaluator) executeMarkedStatement(stmt string) error {
+	content := strings.TrimPrefix(stmt, "(at ")
+	content = strings.TrimSuffix(content, ")")
+
+	parts := strings.SplitN(content, " ", 2)
+	if len(parts) < 2 {
+		return NewEvaluationError(ErrInvalidExpression, stmt)
+	}
+	line, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return NewEvaluationError(ErrInvalidExpression, stmt)
+	}
+	inner := parts[1]
+
+	previousLine := e.line
+	e.line = line
+	e.depth++
+	defer func() {
+		e.depth--
+		e.line = previousLine
+	}()
+
+	if e.hooks != nil && e.hooks.BeforeStatement != nil {
+		if err := e.hooks.BeforeStatement(line, inner, e.depth); err != nil {
+			return err
+		}
+	}
+
+	// Runtime errors raised without a line happened on this statement
+	err = e.atLine(e.executeStatement(inner))
+
+	if e.hooks != nil && e.hooks.AfterStatement != nil {
+		e.hooks.AfterStatement(line, inner, e.depth)
+	}
+	return err
+}
+
 // Execute a block statement
 func (e *Evaluator) executeBlockStatement(stmt string) error {
 	// Extract the block content
@@ -108,12 +270,26 @@ func (e *Evaluator) executeBlockStatement(stmt string) error {
 		return nil
 	}
 	
+	if e.limits != nil {
+		if err := e.limits.EnterScope(e.line); err != nil {
+			return err
+		}
+		defer e.limits.LeaveScope()
+	}
+
 	// Create a new environment for this block
 	previousEnv := e.environment
 	e.environment = NewLocalEnvironment(previousEnv)
 	
-	// Extract each statement in the block
-	statements := parseBlockStatements(blockContent)
+	// Extract each statement in the block, once per distinct block
+	statements, ok := e.blocks[blockContent]
+	if !ok {
+		statements = parseBlockStatements(blockContent)
+		if e.blocks == nil {
+			e.blocks = make(map[string][]string)
+		}
+		e.blocks[blockContent] = statements
+	}
 	
 	// Execute each statement in the block
 	var err error
@@ -172,17 +348,28 @@ func (e *Evaluator) executePrintStatement(stmt string) error {
 		return err
 	}
 	
-	// If the result is a string literal (surrounded by quotes), remove the quotes for output
-	if strings.HasPrefix(result, "\"") && strings.HasSuffix(result, "\"") {
-		result = result[1:len(result)-1]
-	}
-	
-	// Print the result to stdout
-	fmt.Println(result)
+	// Print the result to the configured output, without quotes around strings
+	fmt.Fprintln(e.out, PrintableValue(result))
 	return nil
 }
 
+// evaluateExpression evaluates an expression and reports its value to the hooks
 func (e *Evaluator) evaluateExpression(expr string) (string, error) {
+	if e.hooks == nil {
+		return e.evalExpression(expr)
+	}
+
+	if e.hooks.BeforeExpression != nil {
+		e.hooks.BeforeExpression(expr)
+	}
+	value, err := e.evalExpression(expr)
+	if e.hooks.AfterExpression != nil {
+		e.hooks.AfterExpression(expr, value, err, e.line)
+	}
+	return value, err
+}
+
+func (e *Evaluator) evalExpression(expr string) (string, error) {
 	// Handle special cases for empty parentheses
 	if expr == "()" || expr == "( )" {
 		return "()", nil
@@ -209,7 +396,15 @@ func (e *Evaluator) evaluateExpression(expr string) (string, error) {
 		content := strings.TrimPrefix(expr, "(string ")
 		content = strings.TrimSuffix(content, ")")
 		// Wrap the content in quotes to preserve it as a single string
-		return "\"" + content + "\"", nil
+		return "\"" + sexpr.UnescapeString(content) + "\"", nil
+	}
+
+	// Handle variables the resolver bound to a slot
+	if strings.HasPrefix(expr, "(local-ref ") && strings.HasSuffix(expr, ")") {
+		return e.evalLocalRef(expr)
+	}
+	if strings.HasPrefix(expr, "(local-assign ") && strings.HasSuffix(expr, ")") {
+		return e.evalLocalAssign(expr)
 	}
 
 	// Handle variable references
@@ -270,7 +465,31 @@ func (e *Evaluator) evaluateExpression(expr string) (string, error) {
 		}
 		
 		// Assign the value to the variable
-		return e.environment.Assign(varName, value, line)
+		result, err := e.environment.Assign(varName, value, line)
+		if err == nil && e.hooks != nil && e.hooks.OnAssign != nil {
+			e.hooks.OnAssign(varName, value, e.line)
+		}
+		return result, err
+	}
+
+	// Handle lists, maps, indexing and calls to built-in functions
+	if strings.HasPrefix(expr, "(list") && strings.HasSuffix(expr, ")") {
+		return e.evalList(expr)
+	}
+	if strings.HasPrefix(expr, "(map") && strings.HasSuffix(expr, ")") {
+		return e.evalMap(expr)
+	}
+	if strings.HasPrefix(expr, "(index ") && strings.HasSuffix(expr, ")") {
+		return e.evalIndex(expr)
+	}
+	if strings.HasPrefix(expr, "(slice ") && strings.HasSuffix(expr, ")") {
+		return e.evalSlice(expr)
+	}
+	if strings.HasPrefix(expr, "(set-index ") && strings.HasSuffix(expr, ")") {
+		return e.evalSetIndex(expr)
+	}
+	if strings.HasPrefix(expr, "(call ") && strings.HasSuffix(expr, ")") {
+		return e.evalCall(expr)
 	}
 
 	// Handle grouped expressions (expressions in parentheses)
@@ -288,6 +507,17 @@ func (e *Evaluator) evaluateExpression(expr string) (string, error) {
 		return e.evalDivide(expr)
 	}
 
+	// Handle modulo, integer division and exponentiation
+	if strings.HasPrefix(expr, "(% ") && strings.HasSuffix(expr, ")") {
+		return e.evalModulo(expr)
+	}
+	if strings.HasPrefix(expr, "(~/ ") && strings.HasSuffix(expr, ")") {
+		return e.evalFloorDivide(expr)
+	}
+	if strings.HasPrefix(expr, "(** ") && strings.HasSuffix(expr, ")") {
+		return e.evalPower(expr)
+	}
+
 	// Handle addition
 	if strings.HasPrefix(expr, "(+ ") && strings.HasSuffix(expr, ")") {
 		// Special case for empty addition like (+ ( ) ) or (+ (+ ( ) ))
@@ -337,6 +567,14 @@ func (e *Evaluator) evaluateExpression(expr string) (string, error) {
 		return e.evalAnd(expr)
 	}
 
+	// Handle conditional and nil-coalescing expressions
+	if strings.HasPrefix(expr, "(? ") && strings.HasSuffix(expr, ")") {
+		return e.evalConditional(expr)
+	}
+	if strings.HasPrefix(expr, "(?? ") && strings.HasSuffix(expr, ")") {
+		return e.evalCoalesce(expr)
+	}
+
 	// Handle equality
 	if strings.HasPrefix(expr, "(== ") && strings.HasSuffix(expr, ")") {
 		return e.evalEqual(expr)
@@ -373,13 +611,9 @@ func (e *Evaluator) evaluateExpression(expr string) (string, error) {
 		return strings.Trim(expr, "\""), nil
 	}
 
-	// Handle number literals
-	if num, err := strconv.ParseFloat(expr, 64); err == nil {
-		// Format the number without trailing zeros
-		if num == float64(int64(num)) {
-			return strconv.FormatInt(int64(num), 10), nil
-		}
-		return strconv.FormatFloat(num, 'f', -1, 64), nil
+	// Handle number literals, formatted without trailing zeros
+	if num, ok := NormalizeNumber(e.numeric, expr); ok {
+		return num, nil
 	}
 
 	// Handle boolean and nil literals
@@ -477,11 +711,12 @@ func IsStringFromContext(expr string) bool {
 	return false
 }
 
-// Evaluate a var declaration statement
+// Evaluate a var or constant declaration statement
 func (e *Evaluator) evaluateVarStatement(stmt string) error {
 	// Extract the variable declaration from the var statement
-	// Format: (var name initializer)
-	varExpr := strings.TrimPrefix(stmt, "(var ")
+	// Format: (var name initializer) or (const name initializer)
+	constant := strings.HasPrefix(stmt, "(const ")
+	varExpr := strings.TrimPrefix(strings.TrimPrefix(stmt, "(var "), "(const ")
 	varExpr = strings.TrimSuffix(varExpr, ")")
 	
 	// Split the variable name and initializer
@@ -500,7 +735,14 @@ func (e *Evaluator) evaluateVarStatement(stmt string) error {
 	}
 	
 	// Define the variable in the environment
-	e.environment.Define(name, value)
+	if constant {
+		e.environment.DefineConstant(name, value)
+	} else {
+		e.environment.Define(name, value)
+	}
+	if e.hooks != nil && e.hooks.OnDefine != nil {
+		e.hooks.OnDefine(name, value, e.line)
+	}
 	
 	return nil
 }
@@ -522,9 +764,13 @@ func (e *Evaluator) executeIfStatement(stmt string) error {
 	
 	// Check if the condition is truthy
 	if isTruthy(conditionResult) {
+		e.reportBranch("then")
 		// Execute the then branch
 		return e.executeStatement(parts.thenBranch)
-	} else if parts.hasElse {
+	}
+
+	e.reportBranch("else")
+	if parts.hasElse {
 		// Execute the else branch if it exists
 		return e.executeStatement(parts.elseBranch)
 	}
@@ -544,14 +790,14 @@ type ifStatementParts struct {
 func parseIfParts(content string) ifStatementParts {
 	parts := ifStatementParts{}
 	
-	// Find the condition by tracking parentheses and other grouping symbols
+	// Find the condition by tracking parentheses
 	depth := 0
 	conditionEnd := 0
 	
 	for i, char := range content {
-		if char == '(' || char == '{' || char == '[' {
+		if char == '(' {
 			depth++
-		} else if char == ')' || char == '}' || char == ']' {
+		} else if char == ')' {
 			depth--
 		} else if char == ' ' && depth == 0 {
 			conditionEnd = i
@@ -580,17 +826,18 @@ func parseIfParts(content string) ifStatementParts {
 	return parts
 }
 
-// Split a string at the top level of nesting (ignoring spaces within nested structures)
+// Split a string at the top level of nesting (ignoring spaces within nested structures).
+// Only parentheses nest in parser output; string literals escape their own.
 func splitAtTopLevel(s string, delimiter rune) []string {
 	var result []string
 	var current string
 	depth := 0
 	
 	for _, char := range s {
-		if char == '(' || char == '{' || char == '[' {
+		if char == '(' {
 			depth++
 			current += string(char)
-		} else if char == ')' || char == '}' || char == ']' {
+		} else if char == ')' {
 			depth--
 			current += string(char)
 		} else if char == delimiter && depth == 0 {
@@ -638,9 +885,9 @@ func (e *Evaluator) executeWhileStatement(stmt string) error {
 	content := strings.TrimPrefix(stmt, "(while ")
 	content = strings.TrimSuffix(content, ")")
 	
-	// Parse the condition and body
+	// Parse the condition, body and the increment of a desugared for loop
 	parts := splitAtTopLevel(content, ' ')
-	if len(parts) != 2 {
+	if len(parts) != 2 && len(parts) != 3 {
 		return NewEvaluationError(ErrInvalidExpression, stmt)
 	}
 	
@@ -660,9 +907,22 @@ func (e *Evaluator) executeWhileStatement(stmt string) error {
 			break
 		}
 		
-		// Execute the body
+		// Execute the body, which may leave the loop or skip to the increment
 		err = e.executeStatement(body)
-		if err != nil {
+		if err == errBreak {
+			break
+		}
+		if err != nil && err != errContinue {
+			return err
+		}
+		if len(parts) == 3 {
+			if _, err := e.evaluateExpression(parts[2]); err != nil {
+				return err
+			}
+		}
+
+		// Stop between iterations if the caller gave up
+		if err := e.checkContext(); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,116 @@
+package evaluator
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Thrown is the error a 🚨 statement raises for a value. Like a runtime
+// error it stops the program unless a 🥅 clause catches it. A map with a
+// "message", such as a caught runtime error, is reported by its message and
+// its "line", if it has one, so rethrowing an error reports it unchanged.
+func Thrown(value string, line int) *RuntimeError {
+	message := PrintableValue(value)
+	if entries, ok := parseMap(value); ok {
+		// String keys are found the same way in every numeric mode
+		if i := findKey(NumericFloat, entries, "\"message\""); i >= 0 {
+			message = PrintableValue(entries[i].value)
+			if i := findKey(NumericFloat, entries, "\"line\""); i >= 0 {
+				if errorLine, ok := integerValue(entries[i].value); ok {
+					line = errorLine
+				}
+			}
+		}
+	}
+	return &RuntimeError{Message: message, Line: line, Value: value}
+}
+
+// Catchable returns the error a 🥅 clause can catch, if err is one. Failed
+// assertions, exceeded limits and cancellation can't be caught.
+func Catchable(err error) (*RuntimeError, bool) {
+	runtimeErr, ok := err.(*RuntimeError)
+	return runtimeErr, ok
+}
+
+// CaughtValue is the value a 🥅 clause binds for an error: the thrown value,
+// or for a runtime error a map of its "message" and "line"
+func CaughtValue(err *RuntimeError) string {
+	if err.Value != "" {
+		return err.Value
+	}
+	return formatMap([]mapEntry{
+		{"\"message\"", "\"" + err.Message + "\""},
+		{"\"line\"", strconv.Itoa(err.Line)},
+	})
+}
+
+// Execute (throw <value>)
+func (e *Evaluator) executeThrowStatement(stmt string) error {
+	value, err := e.evaluateExpression(strings.TrimSuffix(strings.TrimPrefix(stmt, "(throw "), ")"))
+	if err != nil {
+		return err
+	}
+	return Thrown(value, e.line)
+}
+
+// Execute (try <block> (catch <name> <block>)? (finally <block>)?). The
+// finally block runs however the others end, even by ⏹️ or ⏭️, and an
+// error or loop exit from it replaces the one it interrupted.
+func (e *Evaluator) executeTryStatement(stmt string) error {
+	content := strings.TrimPrefix(stmt, "(try ")
+	content = strings.TrimSuffix(content, ")")
+
+	parts := splitExpressions(content)
+	if len(parts) < 2 || len(parts) > 3 {
+		return NewEvaluationError(ErrInvalidExpression, stmt)
+	}
+	var catchClause, finallyClause string
+	for _, part := range parts[1:] {
+		switch {
+		case strings.HasPrefix(part, "(catch "):
+			catchClause = part
+		case strings.HasPrefix(part, "(finally "):
+			finallyClause = part
+		default:
+			return NewEvaluationError(ErrInvalidExpression, stmt)
+		}
+	}
+
+	err := e.executeStatement(parts[0])
+	if runtimeErr, ok := Catchable(err); ok && catchClause != "" {
+		err = e.executeCatch(catchClause, runtimeErr)
+	}
+	if finallyClause != "" {
+		body := strings.TrimSuffix(strings.TrimPrefix(finallyClause, "(finally "), ")")
+		if finallyErr := e.executeStatement(body); finallyErr != nil {
+			return finallyErr
+		}
+	}
+	return err
+}
+
+// executeCatch runs (catch <name> <block>) for an error, with the name
+// bound to the caught value in an environment of its own
+func (e *Evaluator) executeCatch(clause string, caught *RuntimeError) error {
+	name, body, ok := strings.Cut(strings.TrimSuffix(strings.TrimPrefix(clause, "(catch "), ")"), " ")
+	if !ok {
+		return NewEvaluationError(ErrInvalidExpression, clause)
+	}
+
+	if e.limits != nil {
+		if err := e.limits.EnterScope(e.line); err != nil {
+			return err
+		}
+		defer e.limits.LeaveScope()
+	}
+	previousEnv := e.environment
+	e.environment = NewLocalEnvironment(previousEnv)
+	defer func() { e.environment = previousEnv }()
+
+	value := CaughtValue(caught)
+	e.environment.DefineSlot(0, name, value)
+	if e.hooks != nil && e.hooks.OnDefine != nil {
+		e.hooks.OnDefine(name, value, e.line)
+	}
+	return e.executeStatement(body)
+}
@@ -0,0 +1,185 @@
+package evaluator
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Iterator steps through the values a for-each loop visits: the numbers in
+// a range, the elements of a list, the entries of a map or the characters
+// of a string. The collection is read once, when the loop starts, so
+// changing the variable it came from does not change the iterations.
+type Iterator struct {
+	keys     []string // Map keys, nil for other collections
+	values   []string
+	position int
+
+	// Ranges compute each number as it is needed
+	isRange          bool
+	mode             NumericMode
+	start, end, step number
+	descending       bool
+}
+
+// NewIterator iterates over a list, map or string value
+func NewIterator(iterable string) (*Iterator, error) {
+	if entries, ok := parseMap(iterable); ok {
+		it := &Iterator{keys: make([]string, len(entries)), values: make([]string, len(entries))}
+		for i, entry := range entries {
+			it.keys[i], it.values[i] = entry.key, entry.value
+		}
+		return it, nil
+	}
+	if isString(iterable) {
+		characters := graphemes(unquote(iterable))
+		for i, character := range characters {
+			characters[i] = "\"" + character + "\""
+		}
+		return &Iterator{values: characters}, nil
+	}
+	if elements, ok := parseList(iterable); ok {
+		return &Iterator{values: elements}, nil
+	}
+	return nil, NewRuntimeError("Can only loop over lists, maps, strings and ranges.", 0)
+}
+
+// NewRangeIterator iterates from start to end inclusive, counting by step.
+// A positive step counts up and a negative one counts down; a range that
+// starts past its end is empty.
+func NewRangeIterator(mode NumericMode, start, end, step string) (*Iterator, error) {
+	startNumber, ok1 := parseNumber(mode, start)
+	endNumber, ok2 := parseNumber(mode, end)
+	stepNumber, ok3 := parseNumber(mode, step)
+	if !ok1 || !ok2 || !ok3 {
+		return nil, NewRuntimeError("Range start, end and step must be numbers.", 0)
+	}
+	if stepNumber.isZero() {
+		return nil, NewRuntimeError("Range step must not be zero.", 0)
+	}
+	zero, _ := parseNumber(mode, "0")
+	return &Iterator{
+		isRange:    true,
+		mode:       mode,
+		start:      startNumber,
+		end:        endNumber,
+		step:       stepNumber,
+		descending: compareNumbers(stepNumber, zero) < 0,
+	}, nil
+}
+
+// Next returns the values for the loop variables of the next iteration,
+// or false when there are none left. With one variable that is the element,
+// or the key of a map entry; with two it is the position, or the key, and
+// the element.
+func (it *Iterator) Next(variables int) ([]string, bool) {
+	var value string
+	if it.isRange {
+		// Multiplying rather than adding up steps keeps fractional steps
+		// from drifting
+		index, _ := parseNumber(it.mode, strconv.Itoa(it.position))
+		current := addNumbers(it.start, multiplyNumbers(index, it.step))
+		order := compareNumbers(current, it.end)
+		if order == 2 || (!it.descending && order > 0) || (it.descending && order < 0) {
+			return nil, false
+		}
+		value = current.String()
+	} else {
+		if it.position >= len(it.values) {
+			return nil, false
+		}
+		value = it.values[it.position]
+	}
+
+	key := strconv.Itoa(it.position)
+	if it.keys != nil {
+		key = it.keys[it.position]
+	}
+	it.position++
+
+	switch {
+	case variables == 2:
+		return []string{key, value}, true
+	case it.keys != nil:
+		return []string{key}, true
+	}
+	return []string{value}, true
+}
+
+// Execute (for-each (<name>...) <iterable> <body>), running the body in a
+// new environment for each iteration that holds the loop variables
+func (e *Evaluator) executeForEachStatement(stmt string) error {
+	content := strings.TrimPrefix(stmt, "(for-each ")
+	content = strings.TrimSuffix(content, ")")
+
+	parts := splitExpressions(content)
+	if len(parts) != 3 {
+		return NewEvaluationError(ErrInvalidExpression, stmt)
+	}
+	names := strings.Fields(strings.Trim(parts[0], "()"))
+	if len(names) == 0 || len(names) > 2 {
+		return NewEvaluationError(ErrInvalidExpression, stmt)
+	}
+
+	it, err := e.iterator(parts[1])
+	if err != nil {
+		return err
+	}
+
+	body := parts[2]
+	for {
+		values, ok := it.Next(len(names))
+		if !ok {
+			return nil
+		}
+
+		if e.limits != nil {
+			if err := e.limits.EnterScope(e.line); err != nil {
+				return err
+			}
+		}
+		previousEnv := e.environment
+		e.environment = NewLocalEnvironment(previousEnv)
+		for slot, name := range names {
+			e.environment.DefineSlot(slot, name, values[slot])
+			if e.hooks != nil && e.hooks.OnDefine != nil {
+				e.hooks.OnDefine(name, values[slot], e.line)
+			}
+		}
+
+		err := e.executeStatement(body)
+		e.environment = previousEnv
+		if e.limits != nil {
+			e.limits.LeaveScope()
+		}
+		if err == errBreak {
+			return nil
+		}
+		if err != nil && err != errContinue {
+			return err
+		}
+
+		// Stop between iterations if the caller gave up
+		if err := e.checkContext(); err != nil {
+			return err
+		}
+	}
+}
+
+// iterator evaluates the collection or range a for-each loop visits
+func (e *Evaluator) iterator(iterable string) (*Iterator, error) {
+	if strings.HasPrefix(iterable, "(range ") && strings.HasSuffix(iterable, ")") {
+		bounds, err := e.evaluateOperands(iterable, "(range ", 3)
+		if err != nil {
+			return nil, err
+		}
+		it, err := NewRangeIterator(e.numeric, bounds[0], bounds[1], bounds[2])
+		return it, e.atLine(err)
+	}
+
+	value, err := e.evaluateExpression(iterable)
+	if err != nil {
+		return nil, err
+	}
+	it, err := NewIterator(value)
+	return it, e.atLine(err)
+}
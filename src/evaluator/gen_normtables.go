@@ -0,0 +1,128 @@
+//go:build ignore
+
+// gen_normtables generates normtables.go from the normalization data in
+// golang.org/x/text. The interpreter itself does not depend on x/text, so
+// run it from a scratch module that does:
+//
+//	go mod init gen && go get golang.org/x/text
+//	go run gen_normtables.go > normtables.go
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func main() {
+	var b strings.Builder
+	fmt.Fprintf(&b, "// Code generated by gen_normtables.go from Unicode %s; DO NOT EDIT.\n\npackage evaluator\n\n", norm.Version)
+
+	// Canonical combining classes
+	var ccc []rune
+	for r := rune(0); r <= utf8.MaxRune; r++ {
+		if r >= 0xd800 && r <= 0xdfff {
+			continue
+		}
+		if norm.NFD.PropertiesString(string(r)).CCC() != 0 {
+			ccc = append(ccc, r)
+		}
+	}
+	b.WriteString("// combiningClasses holds the canonical combining class of every\n// character whose class is not zero\nvar combiningClasses = map[rune]uint8{\n")
+	writeRows(&b, len(ccc), func(i int) string {
+		return fmt.Sprintf("0x%04x: %d,", ccc[i], norm.NFD.PropertiesString(string(ccc[i])).CCC())
+	})
+	b.WriteString("}\n\n")
+
+	// Full canonical decompositions, except Hangul syllables
+	var decomposable []rune
+	for r := rune(0); r <= utf8.MaxRune; r++ {
+		if (r >= 0xd800 && r <= 0xdfff) || (r >= 0xac00 && r <= 0xd7a3) {
+			continue
+		}
+		if d := norm.NFD.String(string(r)); d != string(r) {
+			decomposable = append(decomposable, r)
+		}
+	}
+	b.WriteString("// decompositions maps characters to their full canonical decomposition;\n// Hangul syllables are decomposed algorithmically\nvar decompositions = map[rune]string{\n")
+	writeRows(&b, len(decomposable), func(i int) string {
+		return fmt.Sprintf("0x%04x: %s,", decomposable[i], quote(norm.NFD.String(string(decomposable[i]))))
+	})
+	b.WriteString("}\n\n")
+
+	// Compatibility decompositions that differ from the canonical ones
+	var compatible []rune
+	for r := rune(0); r <= utf8.MaxRune; r++ {
+		if (r >= 0xd800 && r <= 0xdfff) || (r >= 0xac00 && r <= 0xd7a3) {
+			continue
+		}
+		if norm.NFKD.String(string(r)) != norm.NFD.String(string(r)) {
+			compatible = append(compatible, r)
+		}
+	}
+	b.WriteString("// compatibilityDecompositions maps characters to their full compatibility\n// decomposition where it differs from the canonical one\nvar compatibilityDecompositions = map[rune]string{\n")
+	writeRows(&b, len(compatible), func(i int) string {
+		return fmt.Sprintf("0x%04x: %s,", compatible[i], quote(norm.NFKD.String(string(compatible[i]))))
+	})
+	b.WriteString("}\n\n")
+
+	// Primary composites: pairs that compose to one character
+	type pair struct{ a, b, c rune }
+	var pairs []pair
+	for _, c := range decomposable {
+		if norm.NFC.String(string(c)) != string(c) {
+			continue // Excluded from composition
+		}
+		// The canonical decomposition of a primary composite is itself
+		// composed from everything but the last mark
+		d := []rune(norm.NFD.String(string(c)))
+		last := d[len(d)-1]
+		first := []rune(norm.NFC.String(string(d[:len(d)-1])))
+		if len(first) != 1 {
+			fmt.Fprintf(os.Stderr, "skipping %U\n", c)
+			continue
+		}
+		if norm.NFC.String(string(first)+string(last)) != string(c) {
+			fmt.Fprintf(os.Stderr, "mismatch %U\n", c)
+			continue
+		}
+		pairs = append(pairs, pair{first[0], last, c})
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].c < pairs[j].c })
+	b.WriteString("// compositions maps pairs of characters to the primary composite they\n// canonically compose to; Hangul syllables are composed algorithmically\nvar compositions = map[[2]rune]rune{\n")
+	writeRows(&b, len(pairs), func(i int) string {
+		return fmt.Sprintf("{0x%04x, 0x%04x}: 0x%04x,", pairs[i].a, pairs[i].b, pairs[i].c)
+	})
+	b.WriteString("}\n")
+	os.Stdout.WriteString(b.String())
+}
+
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		if r > 0xffff {
+			fmt.Fprintf(&b, "\\U%08x", r)
+		} else {
+			fmt.Fprintf(&b, "\\u%04x", r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// writeRows writes entries several to a line
+func writeRows(b *strings.Builder, n int, entry func(int) string) {
+	const width = 4
+	for i := 0; i < n; i += width {
+		parts := []string{}
+		for j := i; j < n && j < i+width; j++ {
+			parts = append(parts, entry(j))
+		}
+		b.WriteString("\t" + strings.Join(parts, " ") + "\n")
+	}
+}
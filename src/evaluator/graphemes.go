@@ -0,0 +1,328 @@
+package evaluator
+
+import (
+	"unicode"
+)
+
+// graphemes splits text into user-perceived characters, the extended
+// grapheme clusters of Unicode Standard Annex #29: a base character keeps
+// its combining marks, variation selectors and skin tone modifiers, emoji
+// joined by a zero width joiner stay together, regional indicators pair up
+// into flags, and consonants joined by a virama form one conjunct in the
+// Indic scripts the standard lists.
+func graphemes(text string) []string {
+	clusters := []string{}
+	start := 0
+	previous := breakOther
+	var state segmenterState
+
+	for i, r := range text {
+		class := breakClass(r)
+		if i > 0 && state.breaksBefore(previous, class, r) {
+			clusters = append(clusters, text[start:i])
+			start = i
+		}
+		state.advance(class, r)
+		previous = class
+	}
+	if start < len(text) {
+		clusters = append(clusters, text[start:])
+	}
+	return clusters
+}
+
+// Grapheme_Cluster_Break values, with Extended_Pictographic, which the
+// rules use alongside them, as a class of its own
+const (
+	breakOther = iota
+	breakCR
+	breakLF
+	breakControl
+	breakExtend
+	breakZWJ
+	breakRegionalIndicator
+	breakPrepend
+	breakSpacingMark
+	breakL
+	breakV
+	breakT
+	breakLV
+	breakLVT
+	breakPictographic
+)
+
+// segmenterState remembers what the sequences some rules look back over
+// have seen so far
+type segmenterState struct {
+	emoji     int // 1 after a pictograph and its extenders, 2 once a ZWJ follows (GB11)
+	conjunct  int // 1 after an Indic consonant and its extenders, 2 once a virama follows (GB9c)
+	regionals int // Regional indicators in a row (GB12, GB13)
+}
+
+// breaksBefore reports whether a cluster boundary falls before r, which
+// follows a character of class previous
+func (s *segmenterState) breaksBefore(previous, class int, r rune) bool {
+	switch {
+	case previous == breakCR && class == breakLF: // GB3
+		return false
+	case previous == breakCR || previous == breakLF || previous == breakControl: // GB4
+		return true
+	case class == breakCR || class == breakLF || class == breakControl: // GB5
+		return true
+	case previous == breakL && (class == breakL || class == breakV || class == breakLV || class == breakLVT): // GB6
+		return false
+	case (previous == breakLV || previous == breakV) && (class == breakV || class == breakT): // GB7
+		return false
+	case (previous == breakLVT || previous == breakT) && class == breakT: // GB8
+		return false
+	case class == breakExtend || class == breakZWJ || class == breakSpacingMark: // GB9, GB9a
+		return false
+	case previous == breakPrepend: // GB9b
+		return false
+	case s.conjunct == 2 && unicode.Is(conjunctConsonants, r): // GB9c
+		return false
+	case s.emoji == 2 && class == breakPictographic: // GB11
+		return false
+	case previous == breakRegionalIndicator && class == breakRegionalIndicator: // GB12, GB13
+		return s.regionals%2 == 0
+	}
+	return true // GB999
+}
+
+// advance moves the state past r
+func (s *segmenterState) advance(class int, r rune) {
+	switch {
+	case class == breakPictographic:
+		s.emoji = 1
+	case class == breakExtend && s.emoji == 1:
+		// Still extending the pictograph
+	case class == breakZWJ && s.emoji == 1:
+		s.emoji = 2
+	default:
+		s.emoji = 0
+	}
+
+	switch {
+	case unicode.Is(conjunctConsonants, r):
+		s.conjunct = 1
+	case isConjunctLinker(r) && s.conjunct != 0:
+		s.conjunct = 2
+	case isConjunctExtend(class, r) && s.conjunct != 0:
+		// Still extending the consonant or virama
+	default:
+		s.conjunct = 0
+	}
+
+	if class == breakRegionalIndicator {
+		s.regionals++
+	} else {
+		s.regionals = 0
+	}
+}
+
+const zeroWidthJoiner = '\u200d'
+
+// breakClass returns the class of r for the cluster rules
+func breakClass(r rune) int {
+	switch {
+	case r == '\r':
+		return breakCR
+	case r == '\n':
+		return breakLF
+	case r == zeroWidthJoiner:
+		return breakZWJ
+	case isRegionalIndicator(r):
+		return breakRegionalIndicator
+	case isExtend(r):
+		return breakExtend
+	case isPrepend(r):
+		return breakPrepend
+	case isControl(r):
+		return breakControl
+	case isPictographic(r):
+		return breakPictographic
+	case isSpacingMark(r):
+		return breakSpacingMark
+	}
+	switch hangulType(r) {
+	case hangulL:
+		return breakL
+	case hangulV:
+		return breakV
+	case hangulT:
+		return breakT
+	case hangulLV:
+		return breakLV
+	case hangulLVT:
+		return breakLVT
+	}
+	return breakOther
+}
+
+// isControl covers line and paragraph separators, control and format
+// characters, and unassigned code points reserved as default ignorable
+func isControl(r rune) bool {
+	switch {
+	case r == '\u200c' || r == zeroWidthJoiner:
+		return false
+	case unicode.In(r, unicode.Zl, unicode.Zp, unicode.Cc, unicode.Cf):
+		return true
+	}
+	return unicode.Is(unicode.Other_Default_Ignorable_Code_Point, r) && !isAssigned(r)
+}
+
+func isAssigned(r rune) bool {
+	for _, table := range unicode.Categories {
+		if unicode.Is(table, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// isExtend covers the characters that attach to the one before them:
+// nonspacing and enclosing marks, the spacing marks that extend a
+// character without changing its width, and skin tone modifiers
+func isExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Other_Grapheme_Extend) ||
+		(r >= 0x1f3fb && r <= 0x1f3ff)
+}
+
+// isPrepend covers the characters that attach to the one after them
+func isPrepend(r rune) bool {
+	if unicode.Is(unicode.Prepended_Concatenation_Mark, r) {
+		return true
+	}
+	switch {
+	case r >= 0x111c2 && r <= 0x111c3, r >= 0x11a84 && r <= 0x11a89:
+		return true
+	}
+	switch r {
+	case 0x0d4e, 0x1193f, 0x11941, 0x11a3a, 0x11d46:
+		return true
+	}
+	return false
+}
+
+// isSpacingMark covers the spacing combining marks that stay with the
+// character before them, less a few Myanmar, Tai Tham and Ahom vowel signs
+// that begin a new one
+func isSpacingMark(r rune) bool {
+	if r == 0x0e33 || r == 0x0eb3 {
+		return true
+	}
+	if !unicode.Is(unicode.Mc, r) {
+		return false
+	}
+	switch {
+	case r >= 0x102b && r <= 0x102c, r >= 0x1062 && r <= 0x1064, r >= 0x1067 && r <= 0x106d,
+		r >= 0x1087 && r <= 0x108c, r >= 0x109a && r <= 0x109c, r >= 0x1a63 && r <= 0x1a64,
+		r >= 0x11720 && r <= 0x11721:
+		return false
+	}
+	switch r {
+	case 0x1038, 0x1083, 0x108f, 0x1a61, 0xaa7b, 0xaa7d:
+		return false
+	}
+	return true
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
+
+// isPictographic reports whether r has the Extended_Pictographic property
+func isPictographic(r rune) bool {
+	return unicode.Is(extendedPictographic, r)
+}
+
+// extendedPictographic lists the Extended_Pictographic characters of the
+// Unicode emoji data, which includes code points reserved for future emoji
+var extendedPictographic = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x00a9, 0x00a9, 1}, {0x00ae, 0x00ae, 1}, {0x203c, 0x203c, 1}, {0x2049, 0x2049, 1},
+		{0x2122, 0x2122, 1}, {0x2139, 0x2139, 1}, {0x2194, 0x2199, 1}, {0x21a9, 0x21aa, 1},
+		{0x231a, 0x231b, 1}, {0x2328, 0x2328, 1}, {0x2388, 0x2388, 1}, {0x23cf, 0x23cf, 1},
+		{0x23e9, 0x23f3, 1}, {0x23f8, 0x23fa, 1}, {0x24c2, 0x24c2, 1}, {0x25aa, 0x25ab, 1},
+		{0x25b6, 0x25b6, 1}, {0x25c0, 0x25c0, 1}, {0x25fb, 0x25fe, 1}, {0x2600, 0x2605, 1},
+		{0x2607, 0x2612, 1}, {0x2614, 0x2685, 1}, {0x2690, 0x2705, 1}, {0x2708, 0x2712, 1},
+		{0x2714, 0x2714, 1}, {0x2716, 0x2716, 1}, {0x271d, 0x271d, 1}, {0x2721, 0x2721, 1},
+		{0x2728, 0x2728, 1}, {0x2733, 0x2734, 1}, {0x2744, 0x2744, 1}, {0x2747, 0x2747, 1},
+		{0x274c, 0x274c, 1}, {0x274e, 0x274e, 1}, {0x2753, 0x2755, 1}, {0x2757, 0x2757, 1},
+		{0x2763, 0x2767, 1}, {0x2795, 0x2797, 1}, {0x27a1, 0x27a1, 1}, {0x27b0, 0x27b0, 1},
+		{0x27bf, 0x27bf, 1}, {0x2934, 0x2935, 1}, {0x2b05, 0x2b07, 1}, {0x2b1b, 0x2b1c, 1},
+		{0x2b50, 0x2b50, 1}, {0x2b55, 0x2b55, 1}, {0x3030, 0x3030, 1}, {0x303d, 0x303d, 1},
+		{0x3297, 0x3297, 1}, {0x3299, 0x3299, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1f000, 0x1f0ff, 1}, {0x1f10d, 0x1f10f, 1}, {0x1f12f, 0x1f12f, 1}, {0x1f16c, 0x1f171, 1},
+		{0x1f17e, 0x1f17f, 1}, {0x1f18e, 0x1f18e, 1}, {0x1f191, 0x1f19a, 1}, {0x1f1ad, 0x1f1e5, 1},
+		{0x1f201, 0x1f20f, 1}, {0x1f21a, 0x1f21a, 1}, {0x1f22f, 0x1f22f, 1}, {0x1f232, 0x1f23a, 1},
+		{0x1f23c, 0x1f23f, 1}, {0x1f249, 0x1f3fa, 1}, {0x1f400, 0x1f53d, 1}, {0x1f546, 0x1f64f, 1},
+		{0x1f680, 0x1f6ff, 1}, {0x1f774, 0x1f77f, 1}, {0x1f7d5, 0x1f7ff, 1}, {0x1f80c, 0x1f80f, 1},
+		{0x1f848, 0x1f84f, 1}, {0x1f85a, 0x1f85f, 1}, {0x1f888, 0x1f88f, 1}, {0x1f8ae, 0x1f8ff, 1},
+		{0x1f90c, 0x1f93a, 1}, {0x1f93c, 0x1f945, 1}, {0x1f947, 0x1faff, 1}, {0x1fc00, 0x1fffd, 1},
+	},
+}
+
+// conjunctConsonants lists the consonants of the scripts whose viramas
+// join consonants into one conjunct: Devanagari, Bengali, Gujarati, Oriya,
+// Telugu and Malayalam (Indic_Conjunct_Break=Consonant)
+var conjunctConsonants = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0915, 0x0939, 1}, {0x0958, 0x095f, 1}, {0x0978, 0x097f, 1},
+		{0x0995, 0x09a8, 1}, {0x09aa, 0x09b0, 1}, {0x09b2, 0x09b2, 1}, {0x09b6, 0x09b9, 1},
+		{0x09dc, 0x09dd, 1}, {0x09df, 0x09df, 1}, {0x09f0, 0x09f1, 1},
+		{0x0a95, 0x0aa8, 1}, {0x0aaa, 0x0ab0, 1}, {0x0ab2, 0x0ab3, 1}, {0x0ab5, 0x0ab9, 1},
+		{0x0af9, 0x0af9, 1},
+		{0x0b15, 0x0b28, 1}, {0x0b2a, 0x0b30, 1}, {0x0b32, 0x0b33, 1}, {0x0b35, 0x0b39, 1},
+		{0x0b5c, 0x0b5d, 1}, {0x0b5f, 0x0b5f, 1}, {0x0b71, 0x0b71, 1},
+		{0x0c15, 0x0c28, 1}, {0x0c2a, 0x0c39, 1}, {0x0c58, 0x0c5a, 1},
+		{0x0d15, 0x0d3a, 1},
+	},
+}
+
+// isConjunctLinker reports whether r is the virama of one of the scripts
+// in conjunctConsonants (Indic_Conjunct_Break=Linker)
+func isConjunctLinker(r rune) bool {
+	switch r {
+	case 0x094d, 0x09cd, 0x0acd, 0x0b4d, 0x0c4d, 0x0d4d:
+		return true
+	}
+	return false
+}
+
+// isConjunctExtend reports whether r may come between a consonant and the
+// virama that joins it to the next (Indic_Conjunct_Break=Extend): a zero
+// width joiner, or an extending mark such as a nukta that combines with
+// the consonant
+func isConjunctExtend(class int, r rune) bool {
+	return class == breakZWJ || (class == breakExtend && combiningClasses[r] != 0)
+}
+
+// Hangul syllables are built from leading consonants (L), vowels (V) and
+// trailing consonants (T), or precomposed as LV and LVT syllables
+const (
+	hangulL = iota + 1
+	hangulV
+	hangulT
+	hangulLV
+	hangulLVT
+)
+
+func hangulType(r rune) int {
+	switch {
+	case r >= 0x1100 && r <= 0x115f, r >= 0xa960 && r <= 0xa97c:
+		return hangulL
+	case r >= 0x1160 && r <= 0x11a7, r >= 0xd7b0 && r <= 0xd7c6:
+		return hangulV
+	case r >= 0x11a8 && r <= 0x11ff, r >= 0xd7cb && r <= 0xd7fb:
+		return hangulT
+	case r >= 0xac00 && r <= 0xd7a3:
+		if (r-0xac00)%28 == 0 {
+			return hangulLV
+		}
+		return hangulLVT
+	}
+	return 0
+}
@@ -0,0 +1,40 @@
+package evaluator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGraphemes(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"", []string{}},
+		{"abc", []string{"a", "b", "c"}},
+		{"\r\n\n", []string{"\r\n", "\n"}},
+		{"e\u0301x", []string{"e\u0301", "x"}},
+		{"👍🏽ok", []string{"👍🏽", "o", "k"}},
+		{"👩\u200d💻!", []string{"👩\u200d💻", "!"}},
+		{"a\u200d💻", []string{"a\u200d", "💻"}},
+		{"❤\ufe0f", []string{"❤\ufe0f"}},
+		{"1\ufe0f\u20e3", []string{"1\ufe0f\u20e3"}},
+		{"🇯🇵🇫🇷🇩", []string{"🇯🇵", "🇫🇷", "🇩"}},
+		{"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", []string{"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f"}},
+		{"한글", []string{"한", "글"}},
+		{"\u1100\u1161\u11a8\uac01", []string{"\u1100\u1161\u11a8", "\uac01"}},
+		{"नमस्ते", []string{"न", "म", "स्ते"}},
+		{"क्षि", []string{"क्षि"}},
+		{"हिन्दी", []string{"हि", "न्दी"}},
+		{"ক্ষ", []string{"ক্ষ"}},
+		{"\u0600١", []string{"\u0600١"}},
+		{"กำ", []string{"กำ"}},
+		{"a\u200bb", []string{"a", "\u200b", "b"}},
+	}
+
+	for _, test := range tests {
+		if got := graphemes(test.text); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("graphemes(%+q) = %+q, want %+q", test.text, got, test.want)
+		}
+	}
+}
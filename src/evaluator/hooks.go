@@ -0,0 +1,59 @@
+package evaluator
+
+// Hooks are optional callbacks that let tools such as the debugger observe
+// a program while it runs. A nil field is simply skipped.
+type Hooks struct {
+	// BeforeStatement is called before each line-marked statement executes.
+	// depth is 1 for top-level statements and grows with nesting.
+	// Returning an error stops execution with that error.
+	BeforeStatement func(line int, stmt string, depth int) error
+
+	// AfterStatement is called once a line-marked statement has finished,
+	// whether or not it succeeded.
+	AfterStatement func(line int, stmt string, depth int)
+
+	// BeforeExpression is called before every expression is evaluated,
+	// including nested sub-expressions.
+	BeforeExpression func(expr string)
+
+	// AfterExpression is called with the value of every evaluated
+	// expression, including nested sub-expressions. err is non-nil if the
+	// evaluation failed.
+	AfterExpression func(expr string, value string, err error, line int)
+
+	// OnDefine is called after a variable is defined in the current environment.
+	OnDefine func(name string, value string, line int)
+
+	// OnAssign is called after an existing variable is assigned a new value.
+	OnAssign func(name string, value string, line int)
+
+	// OnBranch is called when an if statement picks a branch, with branch set
+	// to "then" or "else". "else" is reported even if there is no else clause.
+	OnBranch func(line int, branch string)
+}
+
+func (e *Evaluator) reportBranch(branch string) {
+	if e.hooks != nil && e.hooks.OnBranch != nil {
+		e.hooks.OnBranch(e.line, branch)
+	}
+}
+
+// SetHooks installs the callbacks used while executing statements
+func (e *Evaluator) SetHooks(hooks *Hooks) {
+	e.hooks = hooks
+}
+
+// Environment returns the innermost environment currently in use
+func (e *Evaluator) Environment() *Environment {
+	return e.environment
+}
+
+// Line returns the source line of the statement currently executing
+func (e *Evaluator) Line() int {
+	return e.line
+}
+
+// Eval evaluates a parsed expression in the current environment
+func (e *Evaluator) Eval(expr string) (string, error) {
+	return e.evaluateExpression(expr)
+}
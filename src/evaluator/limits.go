@@ -0,0 +1,125 @@
+package evaluator
+
+import (
+	"fmt"
+	"time"
+)
+
+// LimitExitCode is the process exit status used when a limit is exceeded
+const LimitExitCode = 75
+
+// Limits bound the resources a program may use, for running untrusted
+// scripts. A zero field means that resource is unlimited.
+type Limits struct {
+	MaxStatements   int           // Statements executed, including repeated loop bodies
+	MaxDuration     time.Duration // Wall-clock time since execution started
+	MaxStringLength int           // Length in bytes of any string, list or map built while running, lists and maps as printed
+	MaxDepth        int           // Nesting depth of block environments
+}
+
+// LimitError is returned when a program exceeds one of its Limits
+type LimitError struct {
+	Message string
+	Line    int
+}
+
+// NewLimitError creates a new LimitError
+func NewLimitError(message string, line int) *LimitError {
+	return &LimitError{
+		Message: message,
+		Line:    line,
+	}
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%s\n[line %d]", e.Message, e.Line)
+}
+
+// SetLimits sets the resource limits enforced while executing statements.
+// The time limit starts counting at the first statement executed.
+func (e *Evaluator) SetLimits(limits Limits) {
+	e.limits = NewLimitTracker(limits)
+}
+
+// LimitTracker counts the resources used by a running program and reports
+// the first limit it exceeds. It is shared by the evaluator and the VM.
+type LimitTracker struct {
+	Limits     Limits
+	statements int
+	depth      int
+	deadline   time.Time
+}
+
+// NewLimitTracker creates a tracker enforcing the given limits
+func NewLimitTracker(limits Limits) *LimitTracker {
+	return &LimitTracker{Limits: limits}
+}
+
+// Statement records that a statement is about to execute
+func (t *LimitTracker) Statement(line int) error {
+	if t.statements == 0 && t.Limits.MaxDuration > 0 {
+		t.deadline = time.Now().Add(t.Limits.MaxDuration)
+	}
+	t.statements++
+	if t.Limits.MaxStatements > 0 && t.statements > t.Limits.MaxStatements {
+		return NewLimitError(fmt.Sprintf("Execution limit exceeded: more than %d statements executed.", t.Limits.MaxStatements), line)
+	}
+	// Reading the clock on every statement is too slow for tight loops
+	if t.Limits.MaxDuration > 0 && t.statements%64 == 0 && time.Now().After(t.deadline) {
+		return NewLimitError(fmt.Sprintf("Execution limit exceeded: ran longer than %s.", t.Limits.MaxDuration), line)
+	}
+	return nil
+}
+
+// Value checks the length of a newly built value. A string is measured by
+// its text and a list or map by its printed form, so a collection that
+// keeps absorbing copies of itself cannot grow without bound.
+func (t *LimitTracker) Value(value string, line int) error {
+	if len(value) < 2 {
+		return nil
+	}
+	switch value[0] {
+	case '"':
+		return t.Length(len(value)-2, line)
+	case '[':
+		return t.size("list", len(value), line)
+	case '{':
+		return t.size("map", len(value), line)
+	}
+	return nil
+}
+
+// Length checks the length in bytes of a string before it is built
+func (t *LimitTracker) Length(n int, line int) error {
+	return t.size("string", n, line)
+}
+
+func (t *LimitTracker) size(kind string, n int, line int) error {
+	if t.Limits.MaxStringLength > 0 && n > t.Limits.MaxStringLength {
+		return NewLimitError(fmt.Sprintf("Execution limit exceeded: %s longer than %d bytes.", kind, t.Limits.MaxStringLength), line)
+	}
+	return nil
+}
+
+// checkSize applies the length limit, if any, to a value built on the
+// current line
+func (e *Evaluator) checkSize(value string) error {
+	if e.limits == nil {
+		return nil
+	}
+	return e.limits.Value(value, e.line)
+}
+
+// EnterScope records that a block environment was created
+func (t *LimitTracker) EnterScope(line int) error {
+	t.depth++
+	if t.Limits.MaxDepth > 0 && t.depth > t.Limits.MaxDepth {
+		return NewLimitError(fmt.Sprintf("Execution limit exceeded: blocks nested deeper than %d.", t.Limits.MaxDepth), line)
+	}
+	return nil
+}
+
+// LeaveScope records that a block environment was discarded
+func (t *LimitTracker) LeaveScope() {
+	t.depth--
+}
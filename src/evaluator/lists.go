@@ -0,0 +1,374 @@
+package evaluator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Lists are values like any other: [1, "a", [2, 3]], with their elements
+// in the evaluator representation separated by ", ". Assigning an element
+// stores an updated list in the variable, so two variables never share a
+// list.
+
+// isList reports whether a value is a list
+func isList(value string) bool {
+	return strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]")
+}
+
+// formatList builds a list value from evaluated elements
+func formatList(elements []string) string {
+	return "[" + strings.Join(elements, ", ") + "]"
+}
+
+// parseList splits a list value into its elements
+func parseList(value string) ([]string, bool) {
+	if !isList(value) {
+		return nil, false
+	}
+	return splitElements(value[1 : len(value)-1]), true
+}
+
+// splitElements splits the inside of a list value at the ", " between
+// elements, skipping those inside strings and nested values. Strings
+// cannot contain a double quote, so a quote always starts or ends one.
+func splitElements(content string) []string {
+	elements := []string{}
+	if content == "" {
+		return elements
+	}
+
+	depth, start, inString := 0, 0, false
+	for i := 0; i < len(content); i++ {
+		switch c := content[i]; {
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == '[' || c == '{':
+			depth++
+		case c == ']' || c == '}':
+			depth--
+		case c == ',' && depth == 0:
+			elements = append(elements, content[start:i])
+			start = i + 2 // Skip the space after the comma
+			i++
+		}
+	}
+	return append(elements, content[start:])
+}
+
+// listsEqual compares two lists element by element
+func listsEqual(mode NumericMode, left, right []string) bool {
+	if len(left) != len(right) {
+		return false
+	}
+	for i := range left {
+		if !valuesEqual(mode, left[i], right[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// integerValue converts a value holding an integer, such as 2 or -1, to int
+func integerValue(value string) (int, bool) {
+	digits, ok := integerDigits(value)
+	if !ok {
+		return 0, false
+	}
+	i, err := strconv.Atoi(digits)
+	return i, err == nil
+}
+
+// elementIndex checks an index into a list or string of the given length,
+// counting negative indices from the end. kind names the indexed value in
+// errors.
+func elementIndex(kind, value string, length int) (int, error) {
+	index, ok := integerValue(value)
+	if !ok {
+		return 0, NewRuntimeError(kind+" index must be an integer.", 0)
+	}
+	if index < 0 {
+		index += length
+	}
+	if index < 0 || index >= length {
+		return 0, NewRuntimeError(fmt.Sprintf("%s index %s out of range for length %d.", kind, value, length), 0)
+	}
+	return index, nil
+}
+
+// sliceBound converts a slice bound to an index between 0 and length,
+// counting negative bounds from the end; nil means the given default
+func sliceBound(value string, length, missing int) (int, error) {
+	if value == "nil" {
+		return missing, nil
+	}
+	bound, ok := integerValue(value)
+	if !ok {
+		return 0, NewRuntimeError("Slice bounds must be integers.", 0)
+	}
+	if bound < 0 {
+		bound += length
+	}
+	return min(max(bound, 0), length), nil
+}
+
+// ListValue builds a list from evaluated elements
+func ListValue(elements []string) string {
+	return formatList(elements)
+}
+
+// IndexValue returns container[index]; a key missing from a map gives nil,
+// and indexing a string gives its grapheme cluster at that position
+func IndexValue(mode NumericMode, container, index string) (string, error) {
+	if entries, ok := parseMap(container); ok {
+		if err := checkKey(index); err != nil {
+			return "", err
+		}
+		if i := findKey(mode, entries, index); i != -1 {
+			return entries[i].value, nil
+		}
+		return "nil", nil
+	}
+
+	if isString(container) {
+		characters := graphemes(unquote(container))
+		i, err := elementIndex("String", index, len(characters))
+		if err != nil {
+			return "", err
+		}
+		return "\"" + characters[i] + "\"", nil
+	}
+
+	elements, ok := parseList(container)
+	if !ok {
+		return "", NewRuntimeError("Can only index lists, maps and strings.", 0)
+	}
+	i, err := elementIndex("List", index, len(elements))
+	if err != nil {
+		return "", err
+	}
+	return elements[i], nil
+}
+
+// SliceValue returns container[start:end] of a list or string; bounds out
+// of range are clamped to it
+func SliceValue(container, start, end string) (string, error) {
+	elements, ok := parseList(container)
+	text := isString(container)
+	if text {
+		elements = graphemes(unquote(container))
+	} else if !ok {
+		return "", NewRuntimeError("Can only slice lists and strings.", 0)
+	}
+	from, err := sliceBound(start, len(elements), 0)
+	if err != nil {
+		return "", err
+	}
+	to, err := sliceBound(end, len(elements), len(elements))
+	if err != nil {
+		return "", err
+	}
+	from = min(from, to)
+	if text {
+		return "\"" + strings.Join(elements[from:to], "") + "\"", nil
+	}
+	return formatList(elements[from:to]), nil
+}
+
+// SetIndexValue returns a copy of container with the element found by
+// following indices replaced by value. Missing map keys are added.
+func SetIndexValue(mode NumericMode, container string, indices []string, value string) (string, error) {
+	if len(indices) == 0 {
+		return value, nil
+	}
+
+	if entries, ok := parseMap(container); ok {
+		if err := checkKey(indices[0]); err != nil {
+			return "", err
+		}
+		element := "nil"
+		if i := findKey(mode, entries, indices[0]); i != -1 {
+			element = entries[i].value
+		}
+		element, err := SetIndexValue(mode, element, indices[1:], value)
+		if err != nil {
+			return "", err
+		}
+		return formatMap(setEntry(mode, entries, indices[0], element)), nil
+	}
+
+	if isString(container) {
+		return "", NewRuntimeError("Strings are immutable.", 0)
+	}
+	elements, ok := parseList(container)
+	if !ok {
+		return "", NewRuntimeError("Can only index lists, maps and strings.", 0)
+	}
+	i, err := elementIndex("List", indices[0], len(elements))
+	if err != nil {
+		return "", err
+	}
+	element, err := SetIndexValue(mode, elements[i], indices[1:], value)
+	if err != nil {
+		return "", err
+	}
+	elements[i] = element
+	return formatList(elements), nil
+}
+
+// Evaluate a list literal: (list <element>...)
+func (e *Evaluator) evalList(expr string) (string, error) {
+	content := strings.TrimPrefix(expr, "(list")
+	content = strings.TrimSuffix(content, ")")
+
+	elements := []string{}
+	for _, elementExpr := range splitExpressions(strings.TrimSpace(content)) {
+		element, err := e.evaluateExpression(elementExpr)
+		if err != nil {
+			return "", err
+		}
+		elements = append(elements, element)
+	}
+	result := formatList(elements)
+	return result, e.checkSize(result)
+}
+
+// Evaluate (index <target> <index>)
+func (e *Evaluator) evalIndex(expr string) (string, error) {
+	values, err := e.evaluateOperands(expr, "(index ", 2)
+	if err != nil {
+		return "", err
+	}
+	result, err := IndexValue(e.numeric, values[0], values[1])
+	return result, e.atLine(err)
+}
+
+// Evaluate (slice <target> <start> <end>)
+func (e *Evaluator) evalSlice(expr string) (string, error) {
+	values, err := e.evaluateOperands(expr, "(slice ", 3)
+	if err != nil {
+		return "", err
+	}
+	result, err := SliceValue(values[0], values[1], values[2])
+	return result, e.atLine(err)
+}
+
+// Evaluate (set-index <variable> <index>... <value>), which stores the
+// updated list in the variable and evaluates to the assigned value
+func (e *Evaluator) evalSetIndex(expr string) (string, error) {
+	content := strings.TrimPrefix(expr, "(set-index ")
+	content = strings.TrimSuffix(content, ")")
+
+	parts := splitExpressions(content)
+	if len(parts) < 3 {
+		return "", NewEvaluationError(ErrInvalidExpression, expr)
+	}
+	variable := parts[0]
+
+	values := make([]string, len(parts))
+	for i, part := range parts {
+		value, err := e.evaluateExpression(part)
+		if err != nil {
+			return "", err
+		}
+		values[i] = value
+	}
+	value := values[len(values)-1]
+
+	updated, err := SetIndexValue(e.numeric, values[0], values[1:len(values)-1], value)
+	if err != nil {
+		return "", e.atLine(err)
+	}
+	if err := e.checkSize(updated); err != nil {
+		return "", err
+	}
+	if err := e.assignVariable(variable, updated); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// assignVariable stores a value in the variable a (var-ref ...) or
+// (local-ref ...) expression refers to
+func (e *Evaluator) assignVariable(ref string, value string) error {
+	var name string
+	var err error
+	if strings.HasPrefix(ref, "(local-ref ") {
+		content := strings.TrimSuffix(strings.TrimPrefix(ref, "(local-ref "), ")")
+		var line, depth, slot int
+		var ok bool
+		name, line, depth, slot, _, ok = localFields(content)
+		if !ok {
+			return NewEvaluationError(ErrInvalidExpression, ref)
+		}
+		_, err = e.environment.AssignSlot(depth, slot, name, value, line)
+	} else {
+		content := strings.TrimSuffix(strings.TrimPrefix(ref, "(var-ref "), ")")
+		var lineText string
+		name, lineText, _ = strings.Cut(content, " ")
+		line, _ := strconv.Atoi(lineText)
+		_, err = e.environment.Assign(name, value, line)
+	}
+
+	if err == nil && e.hooks != nil && e.hooks.OnAssign != nil {
+		e.hooks.OnAssign(name, value, e.line)
+	}
+	return err
+}
+
+// evaluateOperands evaluates the count operands of an expression with the
+// given prefix, left to right
+func (e *Evaluator) evaluateOperands(expr, prefix string, count int) ([]string, error) {
+	content := strings.TrimPrefix(expr, prefix)
+	content = strings.TrimSuffix(content, ")")
+
+	parts := splitExpressions(content)
+	if len(parts) != count {
+		return nil, NewEvaluationError(ErrInvalidExpression, expr)
+	}
+
+	values := make([]string, count)
+	for i, part := range parts {
+		value, err := e.evaluateExpression(part)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// atLine reports a runtime error raised without a line on the line of the
+// statement being executed
+func (e *Evaluator) atLine(err error) error {
+	if runtimeErr, ok := err.(*RuntimeError); ok && runtimeErr.Line == 0 {
+		runtimeErr.Line = e.line
+	}
+	return err
+}
+
+// splitExpressions splits space-separated expressions in parser output.
+// Unlike splitAtTopLevel it only counts parentheses, so brackets inside
+// string literals do not matter.
+func splitExpressions(content string) []string {
+	parts := []string{}
+	depth, start := 0, 0
+	for i := 0; i < len(content); i++ {
+		switch content[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ' ':
+			if depth == 0 {
+				parts = append(parts, content[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if start < len(content) {
+		parts = append(parts, content[start:])
+	}
+	return parts
+}
@@ -0,0 +1,98 @@
+package evaluator
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Evaluate (local-ref <name> <line> <depth> <slot>)
+func (e *Evaluator) evalLocalRef(expr string) (string, error) {
+	content := strings.TrimPrefix(expr, "(local-ref ")
+	content = strings.TrimSuffix(content, ")")
+
+	name, line, depth, slot, _, ok := localFields(content)
+	if !ok {
+		return "", NewEvaluationError(ErrInvalidExpression, expr)
+	}
+
+	value, err := e.environment.GetSlot(depth, slot, name)
+	if err != nil {
+		// Report undefined variables on the line of the reference
+		if runtimeErr, ok := err.(*RuntimeError); ok {
+			runtimeErr.Line = line
+		}
+		return "", err
+	}
+	return value, nil
+}
+
+// Evaluate (local-assign <name> <line> <depth> <slot> <value>)
+func (e *Evaluator) evalLocalAssign(expr string) (string, error) {
+	content := strings.TrimPrefix(expr, "(local-assign ")
+	content = strings.TrimSuffix(content, ")")
+
+	name, line, depth, slot, valueExpr, ok := localFields(content)
+	if !ok || valueExpr == "" {
+		return "", NewEvaluationError(ErrInvalidExpression, expr)
+	}
+
+	value, err := e.evaluateExpression(valueExpr)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := e.environment.AssignSlot(depth, slot, name, value, line)
+	if err == nil && e.hooks != nil && e.hooks.OnAssign != nil {
+		e.hooks.OnAssign(name, value, e.line)
+	}
+	return result, err
+}
+
+// Evaluate (local-var <name> <slot> <initializer>), or local-const
+func (e *Evaluator) evaluateLocalVarStatement(stmt string) error {
+	constant := strings.HasPrefix(stmt, "(local-const ")
+	content := strings.TrimPrefix(strings.TrimPrefix(stmt, "(local-var "), "(local-const ")
+	content = strings.TrimSuffix(content, ")")
+
+	name, rest, _ := strings.Cut(content, " ")
+	slotText, initializer, ok := strings.Cut(rest, " ")
+	slot, err := strconv.Atoi(slotText)
+	if !ok || err != nil {
+		return NewEvaluationError(ErrInvalidExpression, stmt)
+	}
+
+	value, err := e.evaluateExpression(initializer)
+	if err != nil {
+		return err
+	}
+
+	if constant {
+		e.environment.DefineConstantSlot(slot, name, value)
+	} else {
+		e.environment.DefineSlot(slot, name, value)
+	}
+	if e.hooks != nil && e.hooks.OnDefine != nil {
+		e.hooks.OnDefine(name, value, e.line)
+	}
+	return nil
+}
+
+// localFields splits "<name> <line> <depth> <slot> [rest]" without
+// allocating, since it runs on every access to a block variable
+func localFields(content string) (name string, line, depth, slot int, rest string, ok bool) {
+	name, content, ok = strings.Cut(content, " ")
+	if !ok {
+		return
+	}
+	var field string
+	numbers := [3]int{}
+	for i := range numbers {
+		field, content, _ = strings.Cut(content, " ")
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			return name, 0, 0, 0, "", false
+		}
+		numbers[i] = n
+	}
+	return name, numbers[0], numbers[1], numbers[2], content, true
+}
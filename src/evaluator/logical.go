@@ -41,19 +41,36 @@ func (e *Evaluator) evalEqual(expr string) (string, error) {
 		return expr, err
 	}
 	
+	return strconv.FormatBool(valuesEqual(e.numeric, leftValue, rightValue)), nil
+}
+
+// valuesEqual compares two evaluated values: numbers numerically, lists
+// element by element, maps entry by entry in any order, anything else by
+// its exact representation
+func valuesEqual(mode NumericMode, leftValue, rightValue string) bool {
 	// Special case for strings vs numbers
-	leftIsNumber := isNumeric(leftValue)
-	rightIsNumber := isNumeric(rightValue)
+	leftNum, leftIsNumber := parseNumber(mode, leftValue)
+	rightNum, rightIsNumber := parseNumber(mode, rightValue)
 	
 	if leftIsNumber && rightIsNumber {
 		// If both are numbers, compare numerically
-		leftNum, _ := strconv.ParseFloat(leftValue, 64)
-		rightNum, _ := strconv.ParseFloat(rightValue, 64)
-		return strconv.FormatBool(leftNum == rightNum), nil
+		return compareNumbers(leftNum, rightNum) == 0
+	}
+	
+	// Lists are equal when their elements are
+	leftElements, leftIsList := parseList(leftValue)
+	rightElements, rightIsList := parseList(rightValue)
+	if leftIsList && rightIsList {
+		return listsEqual(mode, leftElements, rightElements)
+	}
+	leftEntries, leftIsMap := parseMap(leftValue)
+	rightEntries, rightIsMap := parseMap(rightValue)
+	if leftIsMap && rightIsMap {
+		return mapsEqual(mode, leftEntries, rightEntries)
 	}
 	
 	// Otherwise compare as strings
-	return strconv.FormatBool(leftValue == rightValue), nil
+	return leftValue == rightValue
 }
 
 func (e *Evaluator) evalNotEqual(expr string) (string, error) {
@@ -73,20 +90,7 @@ func (e *Evaluator) evalNotEqual(expr string) (string, error) {
 		return expr, err
 	}
 	
-	
-	// Special case for strings vs numbers
-	leftIsNumber := isNumeric(leftValue)
-	rightIsNumber := isNumeric(rightValue)
-	
-	if leftIsNumber && rightIsNumber {
-		// If both are numbers, compare numerically
-		leftNum, _ := strconv.ParseFloat(leftValue, 64)
-		rightNum, _ := strconv.ParseFloat(rightValue, 64)
-		return strconv.FormatBool(leftNum != rightNum), nil
-	}
-	
-	// Otherwise compare as strings
-	return strconv.FormatBool(leftValue != rightValue), nil
+	return strconv.FormatBool(!valuesEqual(e.numeric, leftValue, rightValue)), nil
 }
 
 // Helper function to check if an expression likely contains a string literal
@@ -166,3 +170,48 @@ func (e *Evaluator) evalAnd(expr string) (string, error) {
 	
 	return rightValue, nil
 }
+
+// Evaluate a conditional expression, evaluating only the chosen branch
+func (e *Evaluator) evalConditional(expr string) (string, error) {
+	// Extract the condition and both branches
+	content := strings.TrimPrefix(expr, "(? ")
+	content = strings.TrimSuffix(content, ")")
+
+	parts := splitAtTopLevel(content, ' ')
+	if len(parts) != 3 {
+		return expr, NewEvaluationError(ErrInvalidExpression, expr)
+	}
+
+	condition, err := e.evaluateExpression(parts[0])
+	if err != nil {
+		return expr, err
+	}
+
+	if isTruthy(condition) {
+		return e.evaluateExpression(parts[1])
+	}
+	return e.evaluateExpression(parts[2])
+}
+
+// Evaluate a nil-coalescing expression, evaluating the default only when
+// the left operand is nil
+func (e *Evaluator) evalCoalesce(expr string) (string, error) {
+	// Extract the operands
+	content := strings.TrimPrefix(expr, "(?? ")
+	content = strings.TrimSuffix(content, ")")
+
+	parts := splitAtTopLevel(content, ' ')
+	if len(parts) != 2 {
+		return expr, NewEvaluationError(ErrInvalidExpression, expr)
+	}
+
+	leftValue, err := e.evaluateExpression(parts[0])
+	if err != nil {
+		return expr, err
+	}
+
+	if leftValue != "nil" {
+		return leftValue, nil
+	}
+	return e.evaluateExpression(parts[1])
+}
@@ -0,0 +1,135 @@
+package evaluator
+
+import (
+	"strings"
+)
+
+// Maps are values like lists: {"name": "Ada", 1: true}, with their entries
+// in insertion order. Keys are strings, numbers or booleans; updating an
+// existing key keeps its position.
+
+// mapEntry is one key and its value, both in the evaluator representation
+type mapEntry struct {
+	key   string
+	value string
+}
+
+// isMap reports whether a value is a map
+func isMap(value string) bool {
+	return strings.HasPrefix(value, "{") && strings.HasSuffix(value, "}")
+}
+
+// formatMap builds a map value from its entries
+func formatMap(entries []mapEntry) string {
+	parts := make([]string, len(entries))
+	for i, entry := range entries {
+		parts[i] = entry.key + ": " + entry.value
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// parseMap splits a map value into its entries
+func parseMap(value string) ([]mapEntry, bool) {
+	if !isMap(value) {
+		return nil, false
+	}
+	parts := splitElements(value[1 : len(value)-1])
+	entries := make([]mapEntry, len(parts))
+	for i, part := range parts {
+		// Keys are scalars, so the first ": " after a string key's closing
+		// quote ends the key
+		keyEnd := 0
+		if strings.HasPrefix(part, "\"") {
+			keyEnd = strings.Index(part[1:], "\"") + 2
+		}
+		separator := strings.Index(part[keyEnd:], ": ")
+		if separator == -1 {
+			return nil, false
+		}
+		entries[i] = mapEntry{part[:keyEnd+separator], part[keyEnd+separator+2:]}
+	}
+	return entries, true
+}
+
+// checkKey reports an error for values that cannot be map keys
+func checkKey(key string) error {
+	if isString(key) || isNumeric(key) || key == "true" || key == "false" {
+		return nil
+	}
+	return NewRuntimeError("Map keys must be strings, numbers or booleans.", 0)
+}
+
+func isString(value string) bool {
+	return len(value) >= 2 && strings.HasPrefix(value, "\"") && strings.HasSuffix(value, "\"")
+}
+
+// findKey returns the position of a key among the entries, or -1
+func findKey(mode NumericMode, entries []mapEntry, key string) int {
+	for i, entry := range entries {
+		if valuesEqual(mode, entry.key, key) {
+			return i
+		}
+	}
+	return -1
+}
+
+// setEntry stores a value under a key, keeping the key's position if it
+// is already present
+func setEntry(mode NumericMode, entries []mapEntry, key, value string) []mapEntry {
+	if i := findKey(mode, entries, key); i != -1 {
+		entries[i].value = value
+		return entries
+	}
+	return append(entries, mapEntry{key, value})
+}
+
+// mapsEqual compares two maps regardless of the order of their entries
+func mapsEqual(mode NumericMode, left, right []mapEntry) bool {
+	if len(left) != len(right) {
+		return false
+	}
+	for _, entry := range left {
+		i := findKey(mode, right, entry.key)
+		if i == -1 || !valuesEqual(mode, entry.value, right[i].value) {
+			return false
+		}
+	}
+	return true
+}
+
+// MapValue builds a map from evaluated keys and values, given in
+// alternating order; a later entry for the same key replaces the value
+func MapValue(mode NumericMode, pairs []string) (string, error) {
+	entries := []mapEntry{}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if err := checkKey(pairs[i]); err != nil {
+			return "", err
+		}
+		entries = setEntry(mode, entries, pairs[i], pairs[i+1])
+	}
+	return formatMap(entries), nil
+}
+
+// Evaluate a map literal: (map <key> <value>...)
+func (e *Evaluator) evalMap(expr string) (string, error) {
+	content := strings.TrimPrefix(expr, "(map")
+	content = strings.TrimSuffix(content, ")")
+
+	pairs := []string{}
+	for _, part := range splitExpressions(strings.TrimSpace(content)) {
+		value, err := e.evaluateExpression(part)
+		if err != nil {
+			return "", err
+		}
+		pairs = append(pairs, value)
+	}
+	if len(pairs)%2 != 0 {
+		return "", NewEvaluationError(ErrInvalidExpression, expr)
+	}
+
+	result, err := MapValue(e.numeric, pairs)
+	if err != nil {
+		return "", e.atLine(err)
+	}
+	return result, e.checkSize(result)
+}
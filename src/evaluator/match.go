@@ -0,0 +1,129 @@
+package evaluator
+
+import "strings"
+
+// MatchesRange reports whether a value lies between low and high inclusive.
+// Numbers match ranges of numbers and strings ranges of strings, ordered
+// like <=; any other value matches no range.
+func MatchesRange(mode NumericMode, value, low, high string) bool {
+	if isString(value) && isString(low) && isString(high) {
+		text := unquote(value)
+		return strings.Compare(unquote(low), text) <= 0 && strings.Compare(text, unquote(high)) <= 0
+	}
+	number, ok1 := parseNumber(mode, value)
+	lowNumber, ok2 := parseNumber(mode, low)
+	highNumber, ok3 := parseNumber(mode, high)
+	if !ok1 || !ok2 || !ok3 {
+		return false
+	}
+	fromLow, toHigh := compareNumbers(lowNumber, number), compareNumbers(number, highNumber)
+	return (fromLow == -1 || fromLow == 0) && (toHigh == -1 || toHigh == 0)
+}
+
+// Execute (match <value> (arm (<pattern>...) <guard> <body>)...), running
+// the body of the first arm with a pattern that matches the value and a
+// truthy guard. A value no arm matches does nothing.
+func (e *Evaluator) executeMatchStatement(stmt string) error {
+	content := strings.TrimPrefix(stmt, "(match ")
+	content = strings.TrimSuffix(content, ")")
+
+	parts := splitExpressions(content)
+	if len(parts) == 0 {
+		return NewEvaluationError(ErrInvalidExpression, stmt)
+	}
+	value, err := e.evaluateExpression(parts[0])
+	if err != nil {
+		return err
+	}
+
+	for _, arm := range parts[1:] {
+		armParts := splitExpressions(strings.TrimSuffix(strings.TrimPrefix(arm, "(arm "), ")"))
+		if !strings.HasPrefix(arm, "(arm ") || len(armParts) != 3 {
+			return NewEvaluationError(ErrInvalidExpression, arm)
+		}
+		patterns := splitExpressions(strings.TrimSuffix(strings.TrimPrefix(armParts[0], "("), ")"))
+
+		if len(patterns) == 1 && strings.HasPrefix(patterns[0], "(bind ") {
+			name := strings.TrimSuffix(strings.TrimPrefix(patterns[0], "(bind "), ")")
+			matched, err := e.executeBindingArm(name, value, armParts[1], armParts[2])
+			if matched || err != nil {
+				return err
+			}
+			continue
+		}
+
+		matched, err := e.matchesAny(value, patterns)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			continue
+		}
+		guard, err := e.evaluateExpression(armParts[1])
+		if err != nil {
+			return err
+		}
+		if isTruthy(guard) {
+			return e.executeStatement(armParts[2])
+		}
+	}
+	return nil
+}
+
+// matchesAny reports whether the value matches one of an arm's patterns
+func (e *Evaluator) matchesAny(value string, patterns []string) (bool, error) {
+	for _, pattern := range patterns {
+		switch {
+		case pattern == "_":
+			return true, nil
+		case strings.HasPrefix(pattern, "(is "):
+			literal, err := e.evaluateExpression(strings.TrimSuffix(strings.TrimPrefix(pattern, "(is "), ")"))
+			if err != nil {
+				return false, err
+			}
+			if valuesEqual(e.numeric, value, literal) {
+				return true, nil
+			}
+		case strings.HasPrefix(pattern, "(range "):
+			bounds, err := e.evaluateOperands(pattern, "(range ", 2)
+			if err != nil {
+				return false, err
+			}
+			if MatchesRange(e.numeric, value, bounds[0], bounds[1]) {
+				return true, nil
+			}
+		default:
+			return false, NewEvaluationError(ErrInvalidExpression, pattern)
+		}
+	}
+	return false, nil
+}
+
+// executeBindingArm runs an arm that binds the value to a name, in a new
+// environment that holds the name for its guard and body. It reports
+// whether the guard let the arm run.
+func (e *Evaluator) executeBindingArm(name, value, guard, body string) (bool, error) {
+	if e.limits != nil {
+		if err := e.limits.EnterScope(e.line); err != nil {
+			return false, err
+		}
+	}
+	previousEnv := e.environment
+	e.environment = NewLocalEnvironment(previousEnv)
+	defer func() {
+		e.environment = previousEnv
+		if e.limits != nil {
+			e.limits.LeaveScope()
+		}
+	}()
+
+	e.environment.DefineSlot(0, name, value)
+	if e.hooks != nil && e.hooks.OnDefine != nil {
+		e.hooks.OnDefine(name, value, e.line)
+	}
+	result, err := e.evaluateExpression(guard)
+	if err != nil || !isTruthy(result) {
+		return false, err
+	}
+	return true, e.executeStatement(body)
+}
@@ -0,0 +1,233 @@
+package evaluator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"moji/src/parser"
+	"moji/src/scanner"
+)
+
+// Modules are .mji files a program imports with 📥. A module runs once, the
+// first time anything imports it, on the evaluator and in a global
+// environment of its own. Every import of it then shares the values its 📤
+// declarations had when it finished.
+
+// ModuleLoader finds modules and caches what they export. Programs given
+// the same loader share its cache, so a module runs once between them.
+type ModuleLoader struct {
+	mu            sync.Mutex // Held while a program imports, including the modules the import runs
+	searchPath    []string
+	exports       map[string][]export // Exports of each module that ran, by absolute path
+	matchWarnings bool
+}
+
+type export struct {
+	name  string
+	value string
+}
+
+// NewModuleLoader creates a loader that looks for modules in the
+// directories of searchPath, in order, when they are not found relative to
+// the file importing them
+func NewModuleLoader(searchPath []string) *ModuleLoader {
+	l := &ModuleLoader{exports: make(map[string][]export)}
+	for _, dir := range searchPath {
+		if dir != "" {
+			l.searchPath = append(l.searchPath, dir)
+		}
+	}
+	return l
+}
+
+// EnableMatchWarnings makes the loader warn about match statements in the
+// modules it parses that may match no arm
+func (l *ModuleLoader) EnableMatchWarnings() {
+	l.matchWarnings = true
+}
+
+// Importer runs the imports of one program or module
+type Importer struct {
+	loader *ModuleLoader
+	chain  []string // The program's file and the modules being loaded, importers first
+	nested bool     // Importing from a module, while the loader is already held
+}
+
+// NewImporter creates the importer of a program in file, which the modules
+// it imports are found relative to. file may be empty for programs that
+// don't come from a file; they import relative to the working directory.
+func NewImporter(loader *ModuleLoader, file string) *Importer {
+	i := &Importer{loader: loader}
+	if file != "" {
+		if abs, err := filepath.Abs(file); err == nil {
+			file = abs
+		}
+		i.chain = []string{file}
+	}
+	return i
+}
+
+// SetModules makes the program import with loader, relative to file
+func (e *Evaluator) SetModules(loader *ModuleLoader, file string) {
+	e.importer = NewImporter(loader, file)
+}
+
+// Import runs the module at a path, given as a string value, unless it
+// already ran, and defines each name it exports in env as a constant named
+// <alias>.<name>. Modules compute numbers in the importing program's mode.
+func (i *Importer) Import(ctx context.Context, env *Environment, path, alias string, out io.Writer, mode NumericMode, limits *LimitTracker) error {
+	if !isString(path) {
+		return NewRuntimeError("Module path must be a string.", 0)
+	}
+	if !i.nested {
+		i.loader.mu.Lock()
+		defer i.loader.mu.Unlock()
+	}
+
+	file, err := i.findModule(unquote(path))
+	if err != nil {
+		return err
+	}
+	exports, ok := i.loader.exports[file]
+	if !ok {
+		if exports, err = i.runModule(ctx, file, out, mode, limits); err != nil {
+			return err
+		}
+		i.loader.exports[file] = exports
+	}
+
+	for _, exported := range exports {
+		env.DefineConstant(alias+"."+exported.name, exported.value)
+	}
+	return nil
+}
+
+// findModule returns the absolute path of a module, looking next to the
+// file importing it and then in each directory of the search path
+func (i *Importer) findModule(path string) (string, error) {
+	candidates := []string{path}
+	if !filepath.IsAbs(path) {
+		dir := "."
+		if len(i.chain) > 0 {
+			dir = filepath.Dir(i.chain[len(i.chain)-1])
+		}
+		candidates = []string{filepath.Join(dir, path)}
+		for _, searchDir := range i.loader.searchPath {
+			candidates = append(candidates, filepath.Join(searchDir, path))
+		}
+	}
+
+	for _, candidate := range candidates {
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return filepath.Abs(candidate)
+		}
+	}
+	return "", NewRuntimeError(fmt.Sprintf("Can't find module '%s'.", path), 0)
+}
+
+// runModule runs a module and returns what it exports. A module that is
+// still loading further up the chain of imports is a cycle.
+func (i *Importer) runModule(ctx context.Context, file string, out io.Writer, mode NumericMode, limits *LimitTracker) ([]export, error) {
+	loading := append(append([]string(nil), i.chain...), file)
+	for _, importer := range i.chain {
+		if importer == file {
+			chain := make([]string, 0, len(loading))
+			for _, path := range loading {
+				chain = append(chain, displayPath(path))
+			}
+			return nil, NewRuntimeError(fmt.Sprintf("Import cycle: %s.", strings.Join(chain, " -> ")), 0)
+		}
+	}
+
+	source, err := os.ReadFile(file)
+	if err != nil {
+		return nil, NewRuntimeError(fmt.Sprintf("Can't read module '%s'.", displayPath(file)), 0)
+	}
+	s := scanner.NewScanner(string(source))
+	tokens := s.ScanTokens()
+	if s.HasError() {
+		return nil, ErrSyntax
+	}
+	p := parser.NewParser(tokens)
+	p.EnableLineMarkers()
+	if i.loader.matchWarnings {
+		p.EnableMatchWarnings()
+	}
+	statements, ok := p.TryParseStatements()
+	if !ok {
+		return nil, ErrSyntax
+	}
+
+	e := NewEvaluator(p)
+	e.importer = &Importer{loader: i.loader, chain: loading, nested: true}
+	e.out = out
+	e.numeric = mode
+	e.limits = limits
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := e.ExecuteStatementsContext(ctx, statements); err != nil {
+		return nil, err
+	}
+
+	exports := make([]export, 0, len(e.exports))
+	for _, name := range e.exports {
+		value, _ := e.environment.Get(name)
+		exports = append(exports, export{name, value})
+	}
+	return exports, nil
+}
+
+// displayPath shows a module's path relative to the working directory
+// when it is inside it
+func displayPath(file string) string {
+	if wd, err := os.Getwd(); err == nil {
+		if relative, err := filepath.Rel(wd, file); err == nil && !strings.HasPrefix(relative, "..") {
+			return relative
+		}
+	}
+	return file
+}
+
+// Execute (import <path> <name>)
+func (e *Evaluator) executeImportStatement(stmt string) error {
+	content := strings.TrimPrefix(stmt, "(import ")
+	content = strings.TrimSuffix(content, ")")
+
+	parts := splitExpressions(content)
+	if len(parts) != 2 {
+		return NewEvaluationError(ErrInvalidExpression, stmt)
+	}
+	path, err := e.evaluateExpression(parts[0])
+	if err != nil {
+		return err
+	}
+	return e.atLine(e.importer.Import(e.ctx, e.environment, path, parts[1], e.out, e.numeric, e.limits))
+}
+
+// Execute (export <declaration>), recording the declared name as one the
+// modules importing this one can use
+func (e *Evaluator) executeExportStatement(stmt string) error {
+	declaration := strings.TrimSuffix(strings.TrimPrefix(stmt, "(export "), ")")
+	fields := strings.Fields(declaration)
+	if len(fields) < 2 {
+		return NewEvaluationError(ErrInvalidExpression, stmt)
+	}
+	if err := e.executeStatement(declaration); err != nil {
+		return err
+	}
+
+	name := fields[1]
+	for _, exported := range e.exports {
+		if exported == name {
+			return nil
+		}
+	}
+	e.exports = append(e.exports, name)
+	return nil
+}
@@ -0,0 +1,120 @@
+package evaluator
+
+import (
+	"fmt"
+	"sort"
+)
+
+// normalize converts text to one of the Unicode normalization forms NFC,
+// NFD, NFKC or NFKD, using the tables in normtables.go
+func normalize(text, form string) (string, error) {
+	var compatibility, composed bool
+	switch form {
+	case "NFC":
+		composed = true
+	case "NFD":
+	case "NFKC":
+		compatibility, composed = true, true
+	case "NFKD":
+		compatibility = true
+	default:
+		return "", NewRuntimeError(fmt.Sprintf("Unknown normalization form '%s'; use NFC, NFD, NFKC or NFKD.", form), 0)
+	}
+
+	runes := decompose(text, compatibility)
+	if composed {
+		runes = compose(runes)
+	}
+	return string(runes), nil
+}
+
+// Hangul syllables decompose into leading consonant, vowel and optional
+// trailing consonant by arithmetic rather than by table
+const (
+	hangulBase      = 0xac00
+	hangulLBase     = 0x1100
+	hangulVBase     = 0x1161
+	hangulTBase     = 0x11a7
+	hangulVCount    = 21
+	hangulTCount    = 28
+	hangulNCount    = hangulVCount * hangulTCount
+	hangulSyllables = 19 * hangulNCount
+)
+
+// decompose fully decomposes text and puts combining marks in canonical
+// order
+func decompose(text string, compatibility bool) []rune {
+	runes := make([]rune, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r >= hangulBase && r < hangulBase+hangulSyllables:
+			index := r - hangulBase
+			runes = append(runes, hangulLBase+index/hangulNCount, hangulVBase+index%hangulNCount/hangulTCount)
+			if t := index % hangulTCount; t != 0 {
+				runes = append(runes, hangulTBase+t)
+			}
+		case compatibility && compatibilityDecompositions[r] != "":
+			runes = append(runes, []rune(compatibilityDecompositions[r])...)
+		case decompositions[r] != "":
+			runes = append(runes, []rune(decompositions[r])...)
+		default:
+			runes = append(runes, r)
+		}
+	}
+
+	// Sort each run of combining marks by combining class, keeping marks of
+	// the same class in order
+	for start := 0; start < len(runes); start++ {
+		if combiningClasses[runes[start]] == 0 {
+			continue
+		}
+		end := start
+		for end < len(runes) && combiningClasses[runes[end]] != 0 {
+			end++
+		}
+		marks := runes[start:end]
+		sort.SliceStable(marks, func(i, j int) bool {
+			return combiningClasses[marks[i]] < combiningClasses[marks[j]]
+		})
+		start = end
+	}
+	return runes
+}
+
+// compose canonically composes decomposed text: each mark that is not
+// blocked from the last starter by a mark of the same or a higher class
+// combines with it if the pair has a primary composite
+func compose(runes []rune) []rune {
+	result := make([]rune, 0, len(runes))
+	starter := -1
+	var lastClass uint8
+	for _, r := range runes {
+		class := combiningClasses[r]
+		if starter >= 0 && (starter == len(result)-1 || (lastClass != 0 && lastClass < class)) {
+			if composite, ok := composePair(result[starter], r); ok {
+				result[starter] = composite
+				continue
+			}
+		}
+		if class == 0 {
+			starter = len(result)
+		}
+		lastClass = class
+		result = append(result, r)
+	}
+	return result
+}
+
+func composePair(first, second rune) (rune, bool) {
+	// Hangul leading consonant and vowel, then syllable and trailing consonant
+	if first >= hangulLBase && first < hangulLBase+19 && second >= hangulVBase && second < hangulVBase+hangulVCount {
+		return hangulBase + ((first-hangulLBase)*hangulVCount+second-hangulVBase)*hangulTCount, true
+	}
+	if first >= hangulBase && first < hangulBase+hangulSyllables && (first-hangulBase)%hangulTCount == 0 &&
+		second > hangulTBase && second < hangulTBase+hangulTCount {
+		return first + second - hangulTBase, true
+	}
+
+	composite, ok := compositions[[2]rune{first, second}]
+	return composite, ok
+}
@@ -0,0 +1,443 @@
+package evaluator
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// NumericMode selects how numbers are represented and computed
+type NumericMode int
+
+const (
+	// NumericFloat computes with floating point, except that integers stay
+	// exact and are promoted to big integers when they overflow int64
+	NumericFloat NumericMode = iota
+	// NumericDecimal computes exactly with decimal numbers
+	NumericDecimal
+)
+
+// DivisionPlaces is the number of decimal places kept when a division in
+// decimal mode does not terminate, as in 1 / 3
+const DivisionPlaces = 28
+
+// SetNumericMode selects how the evaluator represents and computes numbers.
+// Statements it runs must have been optimized in the same mode.
+func (e *Evaluator) SetNumericMode(mode NumericMode) {
+	e.numeric = mode
+}
+
+// ParseNumericMode converts a mode name, "float" or "decimal", to a NumericMode
+func ParseNumericMode(name string) (NumericMode, error) {
+	switch name {
+	case "float":
+		return NumericFloat, nil
+	case "decimal":
+		return NumericDecimal, nil
+	}
+	return NumericFloat, fmt.Errorf("unknown numeric mode %q (expected float or decimal)", name)
+}
+
+func (m NumericMode) String() string {
+	if m == NumericDecimal {
+		return "decimal"
+	}
+	return "float"
+}
+
+// NormalizeNumber formats a number literal the way the evaluator prints
+// numbers in the given mode, for example 5.0 as 5
+func NormalizeNumber(mode NumericMode, text string) (string, bool) {
+	n, ok := parseNumber(mode, text)
+	if !ok {
+		return "", false
+	}
+	return n.String(), true
+}
+
+type numberKind int
+
+const (
+	smallInt numberKind = iota // Exact integer that fits in int64
+	bigInt                     // Exact integer beyond int64
+	float                      // Floating point
+	decimal                    // Exact decimal, in decimal mode
+)
+
+// number is a parsed numeric value
+type number struct {
+	kind numberKind
+	i    int64
+	b    *big.Int
+	f    float64
+	d    *big.Rat
+}
+
+// parseNumber parses a number value in a numeric mode
+func parseNumber(mode NumericMode, value string) (number, bool) {
+	if digits, ok := integerDigits(value); ok {
+		if i, err := strconv.ParseInt(digits, 10, 64); err == nil {
+			if mode == NumericDecimal {
+				return number{kind: decimal, d: new(big.Rat).SetInt64(i)}, true
+			}
+			return number{kind: smallInt, i: i}, true
+		}
+		b, _ := new(big.Int).SetString(digits, 10)
+		if mode == NumericDecimal {
+			return number{kind: decimal, d: new(big.Rat).SetInt(b)}, true
+		}
+		return number{kind: bigInt, b: b}, true
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil && !isRangeError(err) {
+		return number{}, false
+	}
+	if mode == NumericDecimal {
+		d, ok := new(big.Rat).SetString(value)
+		if !ok {
+			return number{}, false
+		}
+		return number{kind: decimal, d: d}, true
+	}
+	return number{kind: float, f: f}, true
+}
+
+func isRangeError(err error) bool {
+	numErr, ok := err.(*strconv.NumError)
+	return ok && numErr.Err == strconv.ErrRange
+}
+
+// integerDigits returns the digits of a plain decimal like -12 or 12.000
+// whose value is an integer
+func integerDigits(value string) (string, bool) {
+	whole, fraction, _ := strings.Cut(value, ".")
+	if strings.Trim(fraction, "0") != "" {
+		return "", false
+	}
+	digits := strings.TrimPrefix(whole, "-")
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return "", false
+	}
+	return whole, true
+}
+
+// exactInteger wraps a big integer, using int64 when it fits
+func exactInteger(b *big.Int) number {
+	if b.IsInt64() {
+		return number{kind: smallInt, i: b.Int64()}
+	}
+	return number{kind: bigInt, b: b}
+}
+
+func (n number) String() string {
+	switch n.kind {
+	case smallInt:
+		return strconv.FormatInt(n.i, 10)
+	case bigInt:
+		return n.b.String()
+	case decimal:
+		return formatDecimal(n.d)
+	}
+	return formatNumber(n.f)
+}
+
+func (n number) isZero() bool {
+	switch n.kind {
+	case smallInt:
+		return n.i == 0
+	case bigInt:
+		return n.b.Sign() == 0
+	case decimal:
+		return n.d.Sign() == 0
+	}
+	return n.f == 0
+}
+
+func (n number) bigInt() *big.Int {
+	if n.kind == bigInt {
+		return n.b
+	}
+	return big.NewInt(n.i)
+}
+
+func (n number) float64() float64 {
+	switch n.kind {
+	case smallInt:
+		return float64(n.i)
+	case bigInt:
+		f, _ := new(big.Float).SetInt(n.b).Float64()
+		return f
+	case decimal:
+		f, _ := n.d.Float64()
+		return f
+	}
+	return n.f
+}
+
+// exact reports whether both numbers are exact integers
+func exact(a, b number) bool {
+	return a.kind != float && b.kind != float
+}
+
+func addNumbers(a, b number) number {
+	if a.kind == decimal {
+		return number{kind: decimal, d: new(big.Rat).Add(a.d, b.d)}
+	}
+	if !exact(a, b) {
+		return number{kind: float, f: a.float64() + b.float64()}
+	}
+	if a.kind == smallInt && b.kind == smallInt {
+		sum := a.i + b.i
+		// Overflow flips the sign away from that of both operands
+		if (sum > a.i) == (b.i > 0) {
+			return number{kind: smallInt, i: sum}
+		}
+	}
+	return exactInteger(new(big.Int).Add(a.bigInt(), b.bigInt()))
+}
+
+func subtractNumbers(a, b number) number {
+	return addNumbers(a, negateNumber(b))
+}
+
+func multiplyNumbers(a, b number) number {
+	if a.kind == decimal {
+		return number{kind: decimal, d: new(big.Rat).Mul(a.d, b.d)}
+	}
+	if !exact(a, b) {
+		return number{kind: float, f: a.float64() * b.float64()}
+	}
+	if a.kind == smallInt && b.kind == smallInt {
+		if a.i == 0 || b.i == 0 {
+			return number{kind: smallInt}
+		}
+		product := a.i * b.i
+		if product/b.i == a.i && !(a.i == -1 && b.i == math.MinInt64) && !(b.i == -1 && a.i == math.MinInt64) {
+			return number{kind: smallInt, i: product}
+		}
+	}
+	return exactInteger(new(big.Int).Mul(a.bigInt(), b.bigInt()))
+}
+
+// divideNumbers divides by a non-zero number. Integers that divide evenly
+// stay exact; other quotients are floats, or rounded decimals in decimal mode.
+func divideNumbers(a, b number) number {
+	if a.kind == decimal {
+		quotient := new(big.Rat).Quo(a.d, b.d)
+		if !terminates(quotient) {
+			quotient.SetString(quotient.FloatString(DivisionPlaces))
+		}
+		return number{kind: decimal, d: quotient}
+	}
+	if exact(a, b) {
+		quotient, remainder := new(big.Int).QuoRem(a.bigInt(), b.bigInt(), new(big.Int))
+		if remainder.Sign() == 0 {
+			return exactInteger(quotient)
+		}
+	}
+	return number{kind: float, f: a.float64() / b.float64()}
+}
+
+// floorDivideNumbers divides by a non-zero number, rounding the quotient
+// down to an integer
+func floorDivideNumbers(a, b number) number {
+	if a.kind == decimal {
+		return number{kind: decimal, d: new(big.Rat).SetInt(floorRat(new(big.Rat).Quo(a.d, b.d)))}
+	}
+	if !exact(a, b) {
+		return number{kind: float, f: math.Floor(a.float64() / b.float64())}
+	}
+	if a.kind == smallInt && b.kind == smallInt && !(a.i == math.MinInt64 && b.i == -1) {
+		quotient := a.i / b.i
+		// Go truncates toward zero, which is one too high for negative quotients
+		if a.i%b.i != 0 && (a.i < 0) != (b.i < 0) {
+			quotient--
+		}
+		return number{kind: smallInt, i: quotient}
+	}
+	return exactInteger(floorRat(new(big.Rat).SetFrac(a.bigInt(), b.bigInt())))
+}
+
+// moduloNumbers returns the remainder of a floored division by a non-zero
+// number, which takes the sign of the divisor: -7 % 3 is 2
+func moduloNumbers(a, b number) number {
+	if exact(a, b) {
+		return subtractNumbers(a, multiplyNumbers(b, floorDivideNumbers(a, b)))
+	}
+	x, y := a.float64(), b.float64()
+	remainder := math.Mod(x, y)
+	if remainder != 0 && (remainder < 0) != (y < 0) {
+		remainder += y
+	}
+	return number{kind: float, f: remainder}
+}
+
+// maxPowerBits bounds the size of an exact power, so a typo like
+// 10 ** 10000000000 fails instead of exhausting memory
+const maxPowerBits = 1 << 24
+
+// powerNumbers raises a to the power b. Exact numbers raised to integer
+// powers give exact results; anything else is computed with floating point.
+func powerNumbers(a, b number) (number, error) {
+	exponent, integral := b.integer()
+	if !exact(a, b) || !integral {
+		result := math.Pow(a.float64(), b.float64())
+		if a.kind != decimal {
+			return number{kind: float, f: result}, nil
+		}
+		if math.IsInf(result, 0) || math.IsNaN(result) {
+			return number{}, NewRuntimeError("Result of ** is not a finite number.", 0)
+		}
+		// Keep only the digits the float actually determines
+		d, _ := new(big.Rat).SetString(strconv.FormatFloat(result, 'g', -1, 64))
+		return number{kind: decimal, d: d}, nil
+	}
+
+	if exponent.Sign() < 0 && a.isZero() {
+		return number{}, NewRuntimeError("Division by zero.", 0)
+	}
+	// Powers of 0, 1 and -1 stay small however large the exponent
+	if !a.isUnit() && (!exponent.IsInt64() || int64(a.bitLength())*exponent.Int64() > maxPowerBits) {
+		return number{}, NewRuntimeError("Exponent too large.", 0)
+	}
+
+	magnitude := new(big.Int).Abs(exponent)
+	var result number
+	one := number{kind: smallInt, i: 1}
+	if a.kind == decimal {
+		numerator := new(big.Int).Exp(a.d.Num(), magnitude, nil)
+		denominator := new(big.Int).Exp(a.d.Denom(), magnitude, nil)
+		result = number{kind: decimal, d: new(big.Rat).SetFrac(numerator, denominator)}
+		one = number{kind: decimal, d: big.NewRat(1, 1)}
+	} else {
+		result = exactInteger(new(big.Int).Exp(a.bigInt(), magnitude, nil))
+	}
+	if exponent.Sign() < 0 {
+		result = divideNumbers(one, result)
+	}
+	return result, nil
+}
+
+// integer returns the value of an exact number that is an integer
+func (n number) integer() (*big.Int, bool) {
+	switch n.kind {
+	case smallInt, bigInt:
+		return n.bigInt(), true
+	case decimal:
+		return n.d.Num(), n.d.IsInt()
+	}
+	return nil, false
+}
+
+// isUnit reports whether a number is 0, 1 or -1
+func (n number) isUnit() bool {
+	i, ok := n.integer()
+	return ok && i.CmpAbs(big.NewInt(1)) <= 0
+}
+
+// bitLength is the number of bits in the integer, or for a fraction in
+// the larger of its numerator and denominator
+func (n number) bitLength() int {
+	switch n.kind {
+	case bigInt:
+		return n.b.BitLen()
+	case decimal:
+		return max(n.d.Num().BitLen(), n.d.Denom().BitLen())
+	}
+	return n.bigInt().BitLen()
+}
+
+// floorRat rounds a fraction down to an integer
+func floorRat(r *big.Rat) *big.Int {
+	// The denominator is always positive, so Euclidean division rounds down
+	return new(big.Int).Div(r.Num(), r.Denom())
+}
+
+func negateNumber(n number) number {
+	switch n.kind {
+	case smallInt:
+		if n.i != math.MinInt64 {
+			return number{kind: smallInt, i: -n.i}
+		}
+		return exactInteger(new(big.Int).Neg(n.bigInt()))
+	case bigInt:
+		return exactInteger(new(big.Int).Neg(n.b))
+	case decimal:
+		return number{kind: decimal, d: new(big.Rat).Neg(n.d)}
+	}
+	return number{kind: float, f: -n.f}
+}
+
+// compareNumbers returns -1, 0 or 1 as a is less than, equal to or greater
+// than b, or 2 when a NaN makes them unordered
+func compareNumbers(a, b number) int {
+	switch {
+	case a.kind == decimal:
+		return a.d.Cmp(b.d)
+	case a.kind == smallInt && b.kind == smallInt:
+		if a.i < b.i {
+			return -1
+		} else if a.i > b.i {
+			return 1
+		}
+		return 0
+	case exact(a, b):
+		return a.bigInt().Cmp(b.bigInt())
+	}
+
+	x, y := a.float64(), b.float64()
+	if x < y {
+		return -1
+	} else if x > y {
+		return 1
+	} else if x == y {
+		return 0
+	}
+	// NaN is unordered, so it compares as neither less, greater nor equal
+	return 2
+}
+
+// terminates reports whether a fraction has a finite decimal expansion,
+// that is whether its denominator has no prime factors but 2 and 5
+func terminates(r *big.Rat) bool {
+	denominator := new(big.Int).Set(r.Denom())
+	for _, factor := range []int64{2, 5} {
+		f := big.NewInt(factor)
+		remainder := new(big.Int)
+		for {
+			quotient, rem := new(big.Int).QuoRem(denominator, f, remainder)
+			if rem.Sign() != 0 {
+				break
+			}
+			denominator = quotient
+		}
+	}
+	return denominator.Cmp(big.NewInt(1)) == 0
+}
+
+// formatDecimal prints an exact decimal without trailing zeros
+func formatDecimal(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+
+	places := DivisionPlaces
+	if terminates(r) {
+		// The smallest power of ten the denominator divides gives the places needed
+		places = 0
+		ten := big.NewInt(10)
+		for power := big.NewInt(1); new(big.Int).Rem(power, r.Denom()).Sign() != 0; places++ {
+			power.Mul(power, ten)
+		}
+	}
+
+	text := strings.TrimRight(r.FloatString(places), "0")
+	text = strings.TrimSuffix(text, ".")
+	if text == "-0" {
+		return "0"
+	}
+	return text
+}
@@ -0,0 +1,91 @@
+package evaluator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The functions below expose the value semantics of the evaluator so other
+// execution engines produce exactly the same results and error messages.
+// Values use the evaluator representation: numbers without trailing zeros,
+// strings wrapped in double quotes, and true, false and nil.
+
+// BinaryOp applies a binary operator, spelled as in the parser output
+// (for example "+" or "<="), to two evaluated values, computing numbers in mode
+func BinaryOp(mode NumericMode, operator string, leftValue, rightValue string) (string, error) {
+	switch operator {
+	case "+":
+		return addValues(mode, leftValue, rightValue)
+	case "-":
+		return subtractValues(mode, leftValue, rightValue)
+	case "*":
+		return multiplyValues(mode, leftValue, rightValue)
+	case "/":
+		return divideValues(mode, leftValue, rightValue)
+	case "%":
+		return moduloValues(mode, leftValue, rightValue)
+	case "~/":
+		return floorDivideValues(mode, leftValue, rightValue)
+	case "**":
+		return powerValues(mode, leftValue, rightValue)
+	case "==":
+		return fmt.Sprint(valuesEqual(mode, leftValue, rightValue)), nil
+	case "!=":
+		return fmt.Sprint(!valuesEqual(mode, leftValue, rightValue)), nil
+	case ">", ">=", "<", "<=":
+		return compareValues(mode, operator, leftValue, rightValue)
+	}
+	return "", NewEvaluationError(ErrInvalidOperator, operator)
+}
+
+// UnaryOp applies "-" or "!" to an evaluated value, computing numbers in mode
+func UnaryOp(mode NumericMode, operator string, value string) (string, error) {
+	switch operator {
+	case "-":
+		return negateValue(mode, value)
+	case "!":
+		return fmt.Sprint(!isTruthy(value)), nil
+	}
+	return "", NewEvaluationError(ErrInvalidOperator, operator)
+}
+
+// IsTruthy reports whether a value counts as true in conditions
+func IsTruthy(value string) bool {
+	return isTruthy(value)
+}
+
+// PrintableValue returns the text a print statement writes for a value
+func PrintableValue(value string) string {
+	// If the result is a string literal (surrounded by quotes), remove the quotes for output
+	if strings.HasPrefix(value, "\"") && strings.HasSuffix(value, "\"") {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// EqualityFailure describes a failed equality assertion, or returns ""
+// if the values are equal in mode
+func EqualityFailure(mode NumericMode, actual, expected string) string {
+	if valuesEqual(mode, actual, expected) {
+		return ""
+	}
+	return fmt.Sprintf("expected %s, got %s", expected, actual)
+}
+
+// TruthyFailure describes a failed assertion on a single value, or returns ""
+// if the value is truthy
+func TruthyFailure(value string) string {
+	if isTruthy(value) {
+		return ""
+	}
+	return fmt.Sprintf("expected a truthy value, got %s", value)
+}
+
+// AssertionFailed builds the error for a failed assertion with an optional
+// user message, which is an evaluated value
+func AssertionFailed(failure string, message string, hasMessage bool, line int) *AssertionError {
+	if hasMessage {
+		return NewAssertionError("Assertion failed: "+unquote(message)+": "+failure+".", line)
+	}
+	return NewAssertionError("Assertion failed: "+failure+".", line)
+}
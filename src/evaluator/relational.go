@@ -1,151 +1,76 @@
 package evaluator
 
 import (
-	"fmt"
-	"os"
 	"strconv"
+	"strings"
 )
 
 func (e *Evaluator) evalGreater(expr string) (string, error) {
-	// Extract the operands
-	left, right, ok := splitOperands(expr)
-	if !ok {
-		return expr, NewEvaluationError(ErrInvalidExpression, expr)
-	}
-	
-	// Evaluate both operands recursively
-	leftValue, err := e.evaluateExpression(left)
-	if err != nil {
-		return expr, err
-	}
-	rightValue, err := e.evaluateExpression(right)
-	if err != nil {
-		return expr, err
-	}
-	
-	// Check for booleans or nil, which are invalid for comparison
-	if isBoolean(leftValue) || isBoolean(rightValue) || leftValue == "nil" || rightValue == "nil" {
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Operands must be numbers.", line)
-	}
-	
-	// Convert to numbers and compare
-	leftNum, err1 := strconv.ParseFloat(leftValue, 64)
-	rightNum, err2 := strconv.ParseFloat(rightValue, 64)
-	if err1 != nil || err2 != nil {
-		fmt.Fprintf(os.Stderr, "Failed to parse operands as numbers for > comparison: %s, %s\n", leftValue, rightValue)
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Operands must be numbers.", line)
-	}
-	
-	// Compare the values
-	return strconv.FormatBool(leftNum > rightNum), nil
+	return e.evalComparison(expr, ">")
 }
 
 func (e *Evaluator) evalGreaterEqual(expr string) (string, error) {
-	// Extract the operands
-	left, right, ok := splitOperands(expr)
-	if !ok {
-		return expr, NewEvaluationError(ErrInvalidExpression, expr)
-	}
-	
-	// Evaluate both operands recursively
-	leftValue, err := e.evaluateExpression(left)
-	if err != nil {
-		return expr, err
-	}
-	rightValue, err := e.evaluateExpression(right)
-	if err != nil {
-		return expr, err
-	}
-	
-	// Check for booleans or nil, which are invalid for comparison
-	if isBoolean(leftValue) || isBoolean(rightValue) || leftValue == "nil" || rightValue == "nil" {
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Operands must be numbers.", line)
-	}
-	
-	// Convert to numbers and compare
-	leftNum, err1 := strconv.ParseFloat(leftValue, 64)
-	rightNum, err2 := strconv.ParseFloat(rightValue, 64)
-	if err1 != nil || err2 != nil {
-		fmt.Fprintf(os.Stderr, "Failed to parse operands as numbers for >= comparison: %s, %s\n", leftValue, rightValue)
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Operands must be numbers.", line)
-	}
-	
-	// Compare the values
-	return strconv.FormatBool(leftNum >= rightNum), nil
+	return e.evalComparison(expr, ">=")
 }
 
 func (e *Evaluator) evalLess(expr string) (string, error) {
-	// Extract the operands
-	left, right, ok := splitOperands(expr)
-	if !ok {
-		return expr, NewEvaluationError(ErrInvalidExpression, expr)
-	}
-	
-	// Evaluate both operands recursively
-	leftValue, err := e.evaluateExpression(left)
-	if err != nil {
-		return expr, err
-	}
-	rightValue, err := e.evaluateExpression(right)
-	if err != nil {
-		return expr, err
-	}
-	
-	// Check for booleans or nil, which are invalid for comparison
-	if isBoolean(leftValue) || isBoolean(rightValue) || leftValue == "nil" || rightValue == "nil" {
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Operands must be numbers.", line)
-	}
-	
-	// Convert to numbers and compare
-	leftNum, err1 := strconv.ParseFloat(leftValue, 64)
-	rightNum, err2 := strconv.ParseFloat(rightValue, 64)
-	if err1 != nil || err2 != nil {
-		fmt.Fprintf(os.Stderr, "Failed to parse operands as numbers for < comparison: %s, %s\n", leftValue, rightValue)
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Operands must be numbers.", line)
-	}
-	
-	// Compare the values
-	return strconv.FormatBool(leftNum < rightNum), nil
+	return e.evalComparison(expr, "<")
 }
 
 func (e *Evaluator) evalLessEqual(expr string) (string, error) {
-	// Extract the operands
-	left, right, ok := splitOperands(expr)
-	if !ok {
-		return expr, NewEvaluationError(ErrInvalidExpression, expr)
-	}
-	
-	// Evaluate both operands recursively
-	leftValue, err := e.evaluateExpression(left)
+	return e.evalComparison(expr, "<=")
+}
+
+// evalComparison evaluates both operands of a comparison and compares them
+func (e *Evaluator) evalComparison(expr string, operator string) (string, error) {
+	leftValue, rightValue, err := e.evalOperands(expr)
 	if err != nil {
 		return expr, err
 	}
-	rightValue, err := e.evaluateExpression(right)
+	
+	result, err := compareValues(e.numeric, operator, leftValue, rightValue)
 	if err != nil {
-		return expr, err
+		return expr, e.atLine(err)
 	}
-	
+	return result, nil
+}
+
+// compareValues applies one of >, >=, < or <= to two evaluated values
+func compareValues(mode NumericMode, operator string, leftValue, rightValue string) (string, error) {
 	// Check for booleans or nil, which are invalid for comparison
 	if isBoolean(leftValue) || isBoolean(rightValue) || leftValue == "nil" || rightValue == "nil" {
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Operands must be numbers.", line)
+		return "", NewRuntimeError("Operands must be numbers.", 0)
+	}
+	
+	// Strings are ordered by code point, and only compare with strings
+	if isString(leftValue) && isString(rightValue) {
+		return orderResult(operator, strings.Compare(unquote(leftValue), unquote(rightValue))), nil
+	}
+	if isString(leftValue) || isString(rightValue) {
+		return "", NewRuntimeError("Operands must be numbers.", 0)
 	}
 	
 	// Convert to numbers and compare
-	leftNum, err1 := strconv.ParseFloat(leftValue, 64)
-	rightNum, err2 := strconv.ParseFloat(rightValue, 64)
-	if err1 != nil || err2 != nil {
-		fmt.Fprintf(os.Stderr, "Failed to parse operands as numbers for <= comparison: %s, %s\n", leftValue, rightValue)
-		line := 1 // Default to line 1
-		return expr, NewRuntimeError("Operands must be numbers.", line)
+	leftNum, ok1 := parseNumber(mode, leftValue)
+	rightNum, ok2 := parseNumber(mode, rightValue)
+	if !ok1 || !ok2 {
+		return "", NewRuntimeError("Operands must be numbers.", 0)
 	}
 	
-	// Compare the values
-	return strconv.FormatBool(leftNum <= rightNum), nil
-} 
\ No newline at end of file
+	return orderResult(operator, compareNumbers(leftNum, rightNum)), nil
+}
+
+// orderResult applies a comparison operator to the order of its operands:
+// -1, 0 or 1, or anything else if they are unordered
+func orderResult(operator string, order int) string {
+	switch operator {
+	case ">":
+		return strconv.FormatBool(order == 1)
+	case ">=":
+		return strconv.FormatBool(order == 1 || order == 0)
+	case "<":
+		return strconv.FormatBool(order == -1)
+	default:
+		return strconv.FormatBool(order == -1 || order == 0)
+	}
+}
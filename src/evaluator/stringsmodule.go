@@ -0,0 +1,353 @@
+package evaluator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// The strings module is called as strings.<name>(...), or 🧵.<name>(...).
+// Lengths, widths and positions count grapheme clusters, like indexing.
+var stringFunctions = map[string]builtin{
+	"upper":            {1, 1, stringsUpper},
+	"lower":            {1, 1, stringsLower},
+	"trim":             {1, 1, stringsTrim},
+	"split":            {2, 2, stringsSplit},
+	"join":             {2, 2, stringsJoin},
+	"replace":          {3, 3, stringsReplace},
+	"contains":         {2, 2, stringsContains},
+	"starts_with":      {2, 2, stringsStartsWith},
+	"ends_with":        {2, 2, stringsEndsWith},
+	"repeat":           {2, 2, nil},
+	"pad_left":         {2, 3, nil},
+	"pad_right":        {2, 3, nil},
+	"reverse":          {1, 1, stringsReverse},
+	"count_codepoints": {1, 1, stringsCountCodepoints},
+	"count_emoji":      {1, 1, stringsCountEmoji},
+	"normalize":        {1, 2, stringsNormalize},
+}
+
+// limitedStringFunctions implement the functions above that are nil
+var limitedStringFunctions = map[string]limitedFunction{
+	"repeat":    stringsRepeat,
+	"pad_left":  stringsPadLeft,
+	"pad_right": stringsPadRight,
+}
+
+func init() {
+	for name, fn := range stringFunctions {
+		builtins["strings."+name] = fn
+		builtins["🧵."+name] = fn
+	}
+	for name, fn := range limitedStringFunctions {
+		limitedBuiltins["strings."+name] = fn
+		limitedBuiltins["🧵."+name] = fn
+	}
+}
+
+// maxRepeatLength caps the strings built by repeat and the pad functions
+// when no string length limit is set, and keeps their lengths from
+// overflowing
+const maxRepeatLength = 1 << 30
+
+// checkLength applies the string length limit, if any, to a result of n
+// bytes before it is built
+func checkLength(n int, limits *LimitTracker) error {
+	if limits == nil {
+		return nil
+	}
+	return limits.Length(n, 0)
+}
+
+// stringArgument returns the text of a string argument
+func stringArgument(function string, args []string, i int) (string, error) {
+	if !isString(args[i]) {
+		return "", NewRuntimeError(fmt.Sprintf("Argument %d to strings.%s must be a string.", i+1, function), 0)
+	}
+	return unquote(args[i]), nil
+}
+
+// stringArguments returns the text of every argument, which must all be
+// strings
+func stringArguments(function string, args []string) ([]string, error) {
+	texts := make([]string, len(args))
+	for i := range args {
+		text, err := stringArgument(function, args, i)
+		if err != nil {
+			return nil, err
+		}
+		texts[i] = text
+	}
+	return texts, nil
+}
+
+// countArgument returns a non-negative integer argument
+func countArgument(function string, args []string, i int) (int, error) {
+	count, ok := integerValue(args[i])
+	if !ok || count < 0 {
+		return 0, NewRuntimeError(fmt.Sprintf("Argument %d to strings.%s must be a non-negative integer.", i+1, function), 0)
+	}
+	return count, nil
+}
+
+func stringValue(text string) string {
+	return "\"" + text + "\""
+}
+
+func stringsUpper(args []string) (string, error) {
+	text, err := stringArgument("upper", args, 0)
+	if err != nil {
+		return "", err
+	}
+	return stringValue(strings.ToUpper(text)), nil
+}
+
+func stringsLower(args []string) (string, error) {
+	text, err := stringArgument("lower", args, 0)
+	if err != nil {
+		return "", err
+	}
+	return stringValue(strings.ToLower(text)), nil
+}
+
+// trim removes white space from both ends
+func stringsTrim(args []string) (string, error) {
+	text, err := stringArgument("trim", args, 0)
+	if err != nil {
+		return "", err
+	}
+	return stringValue(strings.TrimSpace(text)), nil
+}
+
+// split(s, separator) lists the parts of s between separators; an empty
+// separator splits s into its characters
+func stringsSplit(args []string) (string, error) {
+	texts, err := stringArguments("split", args)
+	if err != nil {
+		return "", err
+	}
+
+	var parts []string
+	if texts[1] == "" {
+		parts = graphemes(texts[0])
+	} else {
+		parts = strings.Split(texts[0], texts[1])
+	}
+	for i, part := range parts {
+		parts[i] = stringValue(part)
+	}
+	return formatList(parts), nil
+}
+
+// join(xs, separator) concatenates the elements of a list, printing those
+// that are not strings the way 📢 would
+func stringsJoin(args []string) (string, error) {
+	elements, ok := parseList(args[0])
+	if !ok {
+		return "", NewRuntimeError("Argument 1 to strings.join must be a list.", 0)
+	}
+	separator, err := stringArgument("join", args, 1)
+	if err != nil {
+		return "", err
+	}
+
+	for i, element := range elements {
+		elements[i] = PrintableValue(element)
+	}
+	return stringValue(strings.Join(elements, separator)), nil
+}
+
+// replace(s, old, new) replaces every occurrence of old; an empty old
+// matches before and after each character, as split("") separates them
+func stringsReplace(args []string) (string, error) {
+	texts, err := stringArguments("replace", args)
+	if err != nil {
+		return "", err
+	}
+	if texts[1] == "" {
+		parts := append([]string{""}, graphemes(texts[0])...)
+		return stringValue(strings.Join(append(parts, ""), texts[2])), nil
+	}
+	return stringValue(strings.ReplaceAll(texts[0], texts[1], texts[2])), nil
+}
+
+func stringsContains(args []string) (string, error) {
+	texts, err := stringArguments("contains", args)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatBool(strings.Contains(texts[0], texts[1])), nil
+}
+
+func stringsStartsWith(args []string) (string, error) {
+	texts, err := stringArguments("starts_with", args)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatBool(strings.HasPrefix(texts[0], texts[1])), nil
+}
+
+func stringsEndsWith(args []string) (string, error) {
+	texts, err := stringArguments("ends_with", args)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatBool(strings.HasSuffix(texts[0], texts[1])), nil
+}
+
+// repeat(s, n) is s n times over
+func stringsRepeat(args []string, limits *LimitTracker) (string, error) {
+	text, err := stringArgument("repeat", args, 0)
+	if err != nil {
+		return "", err
+	}
+	count, err := countArgument("repeat", args, 1)
+	if err != nil {
+		return "", err
+	}
+	if count > 0 && len(text) > maxRepeatLength/count {
+		return "", NewRuntimeError("Result of strings.repeat is too long.", 0)
+	}
+	if err := checkLength(len(text)*count, limits); err != nil {
+		return "", err
+	}
+	return stringValue(strings.Repeat(text, count)), nil
+}
+
+func stringsPadLeft(args []string, limits *LimitTracker) (string, error) {
+	return pad("pad_left", args, true, limits)
+}
+
+func stringsPadRight(args []string, limits *LimitTracker) (string, error) {
+	return pad("pad_right", args, false, limits)
+}
+
+// pad(s, width, fill) extends s to width characters with fill, a space by
+// default, repeating fill as often as needed
+func pad(function string, args []string, left bool, limits *LimitTracker) (string, error) {
+	text, err := stringArgument(function, args, 0)
+	if err != nil {
+		return "", err
+	}
+	width, err := countArgument(function, args, 1)
+	if err != nil {
+		return "", err
+	}
+	fill := " "
+	if len(args) == 3 {
+		if fill, err = stringArgument(function, args, 2); err != nil {
+			return "", err
+		}
+		if fill == "" {
+			return "", NewRuntimeError(fmt.Sprintf("Argument 3 to strings.%s must not be empty.", function), 0)
+		}
+	}
+
+	missing := width - len(graphemes(text))
+	if missing <= 0 {
+		return stringValue(text), nil
+	}
+	if missing > maxRepeatLength/len(fill) {
+		return "", NewRuntimeError(fmt.Sprintf("Result of strings.%s is too long.", function), 0)
+	}
+	fillCharacters := graphemes(fill)
+	partial := strings.Join(fillCharacters[:missing%len(fillCharacters)], "")
+	length := len(text) + missing/len(fillCharacters)*len(fill) + len(partial)
+	if err := checkLength(length, limits); err != nil {
+		return "", err
+	}
+	padding := make([]string, missing)
+	for i := range padding {
+		padding[i] = fillCharacters[i%len(fillCharacters)]
+	}
+	if left {
+		return stringValue(strings.Join(padding, "") + text), nil
+	}
+	return stringValue(text + strings.Join(padding, "")), nil
+}
+
+// reverse reverses the characters of s, keeping each grapheme cluster intact
+func stringsReverse(args []string) (string, error) {
+	text, err := stringArgument("reverse", args, 0)
+	if err != nil {
+		return "", err
+	}
+	characters := graphemes(text)
+	for i, j := 0, len(characters)-1; i < j; i, j = i+1, j-1 {
+		characters[i], characters[j] = characters[j], characters[i]
+	}
+	return stringValue(strings.Join(characters, "")), nil
+}
+
+// count_codepoints is the number of Unicode code points in s
+func stringsCountCodepoints(args []string) (string, error) {
+	text, err := stringArgument("count_codepoints", args, 0)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(utf8.RuneCountInString(text)), nil
+}
+
+// count_emoji is the number of characters in s that display as emoji
+func stringsCountEmoji(args []string) (string, error) {
+	text, err := stringArgument("count_emoji", args, 0)
+	if err != nil {
+		return "", err
+	}
+	count := 0
+	for _, character := range graphemes(text) {
+		if isEmoji(character) {
+			count++
+		}
+	}
+	return strconv.Itoa(count), nil
+}
+
+// normalize(s, form) converts s to a Unicode normalization form: "NFC" (the
+// default), "NFD", "NFKC" or "NFKD"
+func stringsNormalize(args []string) (string, error) {
+	texts, err := stringArguments("normalize", args)
+	if err != nil {
+		return "", err
+	}
+	form := "NFC"
+	if len(texts) == 2 {
+		form = texts[1]
+	}
+	normalized, err := normalize(texts[0], form)
+	if err != nil {
+		return "", err
+	}
+	return stringValue(normalized), nil
+}
+
+// isEmoji reports whether a grapheme cluster displays as an emoji: a flag,
+// a pictograph shown as an emoji by default, or any character followed by
+// the emoji variation selector or the keycap mark
+func isEmoji(character string) bool {
+	if strings.ContainsAny(character, "\ufe0f\u20e3") {
+		return true
+	}
+	first, _ := utf8.DecodeRuneInString(character)
+	return isRegionalIndicator(first) || (isPictographic(first) && (first >= 0x1f000 || hasEmojiPresentation(first)))
+}
+
+// hasEmojiPresentation reports whether a pictograph below U+1F000 displays
+// as an emoji without a variation selector
+func hasEmojiPresentation(r rune) bool {
+	switch {
+	case r >= 0x231a && r <= 0x231b, r >= 0x23e9 && r <= 0x23ec, r >= 0x25fd && r <= 0x25fe,
+		r >= 0x2614 && r <= 0x2615, r >= 0x2648 && r <= 0x2653, r >= 0x26aa && r <= 0x26ab,
+		r >= 0x26bd && r <= 0x26be, r >= 0x26c4 && r <= 0x26c5, r >= 0x26f2 && r <= 0x26f3,
+		r >= 0x270a && r <= 0x270b, r >= 0x2753 && r <= 0x2755, r >= 0x2795 && r <= 0x2797,
+		r >= 0x2b1b && r <= 0x2b1c:
+		return true
+	}
+	switch r {
+	case 0x23f0, 0x23f3, 0x267f, 0x2693, 0x26a1, 0x26ce, 0x26d4, 0x26ea, 0x26f5, 0x26fa,
+		0x26fd, 0x2705, 0x2728, 0x274c, 0x274e, 0x2757, 0x27b0, 0x27bf, 0x2b50, 0x2b55:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package evaluator
+
+import (
+	"strconv"
+	"strings"
+
+	"moji/src/resolver"
+	"moji/src/sexpr"
+)
+
+// TestCase is a test block declared at the top level of a program
+type TestCase struct {
+	Name string
+	Line int
+	body string
+}
+
+// FindTests separates the top-level test blocks from the other statements
+// of a parsed program. Statements may carry line markers.
+func FindTests(statements []string) (setup []string, tests []TestCase) {
+	for _, stmt := range statements {
+		line := 0
+		inner := stmt
+		if strings.HasPrefix(inner, "(at ") {
+			parts := strings.SplitN(strings.TrimSuffix(strings.TrimPrefix(inner, "(at "), ")"), " ", 2)
+			if len(parts) == 2 {
+				line, _ = strconv.Atoi(parts[0])
+				inner = parts[1]
+			}
+		}
+
+		if !strings.HasPrefix(inner, "(test ") {
+			setup = append(setup, stmt)
+			continue
+		}
+
+		content := strings.TrimPrefix(inner, "(test ")
+		content = strings.TrimSuffix(content, ")")
+		parts := splitAtTopLevel(content, ' ')
+		if len(parts) != 2 {
+			setup = append(setup, stmt)
+			continue
+		}
+
+		name := strings.TrimPrefix(parts[0], "(string ")
+		name = sexpr.UnescapeString(strings.TrimSuffix(name, ")"))
+		tests = append(tests, TestCase{Name: name, Line: line, body: parts[1]})
+	}
+
+	return setup, tests
+}
+
+// RunTest executes the body of a test case in the current environment
+func (e *Evaluator) RunTest(test TestCase) error {
+	e.line = test.Line
+	return e.executeStatement(resolver.Resolve([]string{test.body})[0])
+}
+
+// Execute an assertion: (assert <line> <expr> <message>?)
+func (e *Evaluator) executeAssertStatement(stmt string) error {
+	content := strings.TrimPrefix(stmt, "(assert ")
+	content = strings.TrimSuffix(content, ")")
+
+	lineStr, rest, ok := strings.Cut(content, " ")
+	if !ok {
+		return NewEvaluationError(ErrInvalidExpression, stmt)
+	}
+	line, _ := strconv.Atoi(lineStr)
+
+	parts := splitAtTopLevel(rest, ' ')
+	if len(parts) < 1 || len(parts) > 2 {
+		return NewEvaluationError(ErrInvalidExpression, stmt)
+	}
+	expr := parts[0]
+
+	var failure string
+	if strings.HasPrefix(expr, "(== ") && strings.HasSuffix(expr, ")") {
+		// Equality assertions report both sides
+		actual, expected, err := e.evalOperands(expr)
+		if err != nil {
+			return err
+		}
+		failure = EqualityFailure(e.numeric, actual, expected)
+	} else {
+		value, err := e.evaluateExpression(expr)
+		if err != nil {
+			return err
+		}
+		failure = TruthyFailure(value)
+	}
+
+	if failure == "" {
+		return nil
+	}
+
+	var message string
+	if len(parts) == 2 {
+		custom, err := e.evaluateExpression(parts[1])
+		if err != nil {
+			return err
+		}
+		message = custom
+	}
+	return AssertionFailed(failure, message, len(parts) == 2, line)
+}
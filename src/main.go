@@ -1,22 +1,102 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
 
+	"moji/src/artifact"
+	"moji/src/coverage"
+	"moji/src/dap"
+	"moji/src/debugger"
 	"moji/src/evaluator"
+	"moji/src/optimizer"
 	"moji/src/parser"
+	"moji/src/profile"
 	"moji/src/scanner"
+	"moji/src/scanner/types"
+	"moji/src/sexpr"
+	"moji/src/tester"
+	"moji/src/trace"
+	"moji/src/vm"
 )
 
 func main() {
-	if len(os.Args) < 3 {
-		fmt.Fprintln(os.Stderr, "Usage: ./your_program.sh <command> <filename>")
-		os.Exit(1)
+	// The debug adapter receives the program to run from its client
+	if len(os.Args) == 2 && os.Args[1] == "dap" {
+		if err := dap.NewServer(os.Stdin, os.Stdout).Serve(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(os.Args) < 2 || (len(os.Args) < 3 && os.Args[1] != "test") {
+		usage()
 	}
 
 	command := os.Args[1]
-	filename := os.Args[2]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	flags.Usage = usage
+	traceEnabled := flags.Bool("trace", false, "")
+	traceFile := flags.String("trace-file", "", "")
+	profileFile := flags.String("profile", "", "")
+	htmlFile := flags.String("html", "", "")
+	annotateFile := flags.String("annotate", "", "")
+	format := flags.String("format", "text", "")
+	useVM := flags.Bool("vm", false, "")
+	noOptimize := flags.Bool("no-optimize", false, "")
+	maxStatements := flags.Int("max-statements", 0, "")
+	timeout := flags.Duration("timeout", 0, "")
+	maxString := flags.Int("max-string", 0, "")
+	maxDepth := flags.Int("max-depth", 0, "")
+	count := flags.Int("count", 10, "")
+	output := flags.String("o", "", "")
+	numeric := flags.String("numeric", "float", "")
+	warnMatch := flags.Bool("warn-match", false, "")
+	modulePath := flags.String("module-path", "", "")
+	flags.Parse(os.Args[2:])
+	searchPath := append(filepath.SplitList(*modulePath), filepath.SplitList(os.Getenv("MOJI_PATH"))...)
+
+	mode, err := evaluator.ParseNumericMode(*numeric)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	limits := evaluator.Limits{
+		MaxStatements:   *maxStatements,
+		MaxDuration:     *timeout,
+		MaxStringLength: *maxString,
+		MaxDepth:        *maxDepth,
+	}
+	limited := limits != evaluator.Limits{}
+
+	// Folding and dead-branch elimination would hide work from the limits,
+	// so a limited run executes the program as written
+	optimize := !*noOptimize && !limited
+
+	// The test runner takes any number of files or directories
+	if command == "test" {
+		runTests(flags.Args(), *format, searchPath, mode, *warnMatch)
+		return
+	}
+
+	if flags.NArg() != 1 {
+		usage()
+	}
+	filename := flags.Arg(0)
+	modules := evaluator.NewModuleLoader(searchPath)
+	if *warnMatch {
+		modules.EnableMatchWarnings()
+	}
 
 	fileContents, err := os.ReadFile(filename)
 	if err != nil {
@@ -24,6 +104,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Compiled artifacts skip scanning and parsing
+	if command == "run" && artifact.IsArtifact(fileContents) {
+		if *traceEnabled || *traceFile != "" || *profileFile != "" {
+			fmt.Fprintln(os.Stderr, "Error: tracing and profiling need the source file, not a compiled artifact")
+			os.Exit(1)
+		}
+		runArtifact(filename, fileContents, *useVM, limits, limited, modules)
+		return
+	}
+
 	switch command {
 	case "tokenize":
 		scanner.Scan(fileContents)
@@ -33,10 +123,10 @@ func main() {
 		if s.HasError() {
 			os.Exit(65)
 		}
-		p := parser.NewParser(tokens)
+		p := newParser(tokens, *warnMatch)
 		statements := p.ParseStatements()
 		for _, stmt := range statements {
-			fmt.Println(stmt)
+			fmt.Println(sexpr.Display(stmt))
 		}
 	case "evaluate":
 		s := scanner.NewScanner(string(fileContents))
@@ -44,8 +134,9 @@ func main() {
 		if s.HasError() {
 			os.Exit(65)
 		}
-		p := parser.NewParser(tokens)
+		p := newParser(tokens, *warnMatch)
 		e := evaluator.NewEvaluator(p)
+		e.SetNumericMode(mode)
 		
 		// The evaluator will handle runtime errors and exit with code 70 if needed
 		result := e.Evaluate()
@@ -56,13 +147,406 @@ func main() {
 		if s.HasError() {
 			os.Exit(65)
 		}
-		p := parser.NewParser(tokens)
+		p := newParser(tokens, *warnMatch)
+
+		// Ctrl-C stops the script and reports the line it was running
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		if *useVM {
+			chunk := compileProgram(p, optimize, mode)
+			machine := vm.NewVM(chunk)
+			machine.SetNumericMode(mode)
+			machine.SetModules(modules, filename)
+			if limited {
+				machine.SetLimits(limits)
+			}
+			evaluator.ExitOnError(machine.RunContext(ctx))
+			return
+		}
+
 		e := evaluator.NewEvaluator(p)
+		e.SetNumericMode(mode)
+		e.SetModules(modules, filename)
+		if limited {
+			e.SetLimits(limits)
+		}
+
+		tracing := *traceEnabled || *traceFile != ""
+		if tracing {
+			out := os.Stderr
+			if *traceFile != "" {
+				out, err = os.Create(*traceFile)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error creating trace file: %v\n", err)
+					os.Exit(1)
+				}
+			}
+			e.SetHooks(trace.NewTracer(out, string(fileContents)).Hooks())
+		}
+
+		if *profileFile != "" {
+			runProfiled(e, filename, string(fileContents), *profileFile)
+			return
+		}
+
+		// Traces report on the program as written, so they skip the optimizer
+		if optimize && !tracing {
+			statements := optimizer.Optimize(parseProgram(p), mode)
+			evaluator.ExitOnError(e.ExecuteStatementsContext(ctx, statements))
+			return
+		}
 		
 		// Evaluate statements, including print statements
-		e.EvaluateStatements()
+		evaluator.ExitOnError(e.ExecuteContext(ctx))
+	case "disasm":
+		s := scanner.NewScanner(string(fileContents))
+		tokens := s.ScanTokens()
+		if s.HasError() {
+			os.Exit(65)
+		}
+		p := newParser(tokens, *warnMatch)
+
+		chunk := compileProgram(p, !*noOptimize, mode)
+		chunk.Disassemble(os.Stdout, filename)
+	case "compile":
+		s := scanner.NewScanner(string(fileContents))
+		tokens := s.ScanTokens()
+		if s.HasError() {
+			os.Exit(65)
+		}
+		p := newParser(tokens, *warnMatch)
+
+		path := *output
+		if path == "" {
+			path = strings.TrimSuffix(filename, filepath.Ext(filename)) + ".mjc"
+		}
+		compileArtifact(p, filename, string(fileContents), path, !*noOptimize, mode)
+	case "bench":
+		s := scanner.NewScanner(string(fileContents))
+		tokens := s.ScanTokens()
+		if s.HasError() {
+			os.Exit(65)
+		}
+		p := newParser(tokens, *warnMatch)
+
+		runBench(p, filename, *useVM, *count, searchPath, mode)
+	case "cover":
+		s := scanner.NewScanner(string(fileContents))
+		tokens := s.ScanTokens()
+		if s.HasError() {
+			os.Exit(65)
+		}
+		p := newParser(tokens, *warnMatch)
+		e := evaluator.NewEvaluator(p)
+		e.SetNumericMode(mode)
+		e.SetModules(modules, filename)
+
+		runCoverage(e, p, filename, string(fileContents), *htmlFile, *annotateFile)
+	case "debug":
+		s := scanner.NewScanner(string(fileContents))
+		tokens := s.ScanTokens()
+		if s.HasError() {
+			os.Exit(65)
+		}
+		p := newParser(tokens, *warnMatch)
+		e := evaluator.NewEvaluator(p)
+		e.SetNumericMode(mode)
+		e.SetModules(modules, filename)
+
+		d := debugger.NewDebugger(e, string(fileContents), os.Stdin, os.Stdout)
+		if err := d.Run(); err == evaluator.ErrSyntax {
+			os.Exit(65)
+		} else if err != nil {
+			os.Exit(70)
+		}
 	default:
 		fmt.Fprintf(os.Stderr, "Unknown command: %s\n", command)
 		os.Exit(1)
 	}
 }
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: ./your_program.sh <command> [options] <filename>")
+	fmt.Fprintln(os.Stderr, "       ./your_program.sh test [--format=text|tap|junit] [paths...]")
+	fmt.Fprintln(os.Stderr, "       ./your_program.sh dap")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "Commands: tokenize, parse, evaluate, run, compile, disasm, bench, cover, debug")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "Options for all commands:")
+	fmt.Fprintln(os.Stderr, "  --numeric=float|decimal  compute with floats and exact integers (default), or exact decimals")
+	fmt.Fprintln(os.Stderr, "  --warn-match             warn about 🎯 statements without a '_' arm")
+	fmt.Fprintln(os.Stderr, "  --module-path=<dirs>     search these directories, separated like $PATH, for imported")
+	fmt.Fprintln(os.Stderr, "                           modules after the importing file's own; $MOJI_PATH is searched last")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "Options for run:")
+	fmt.Fprintln(os.Stderr, "  --trace             log executed statements, values and assignments to stderr")
+	fmt.Fprintln(os.Stderr, "  --trace-file=<path> write the trace to a file instead of stderr")
+	fmt.Fprintln(os.Stderr, "  --profile=<path>    report hot lines on stderr and write folded stacks to a file")
+	fmt.Fprintln(os.Stderr, "  --vm                compile to bytecode and run it on the virtual machine")
+	fmt.Fprintln(os.Stderr, "  --no-optimize       skip constant folding and dead-branch elimination")
+	fmt.Fprintln(os.Stderr, "  --max-statements=<n> abort after executing n statements")
+	fmt.Fprintln(os.Stderr, "  --timeout=<duration> abort after running for the given time, e.g. 2s")
+	fmt.Fprintln(os.Stderr, "  --max-string=<n>    abort when a program builds a string, list or map over n bytes")
+	fmt.Fprintln(os.Stderr, "  --max-depth=<n>     abort when blocks nest deeper than n")
+	fmt.Fprintln(os.Stderr, "  (programs that exceed a limit exit with code 75)")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "Options for compile:")
+	fmt.Fprintln(os.Stderr, "  -o <path>           where to write the artifact (default: <filename>.mjc)")
+	fmt.Fprintln(os.Stderr, "  --no-optimize       skip constant folding and dead-branch elimination")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "Options for bench:")
+	fmt.Fprintln(os.Stderr, "  --count=<n>         number of timed runs (default 10)")
+	fmt.Fprintln(os.Stderr, "  --vm                benchmark the virtual machine instead of the evaluator")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "Options for cover:")
+	fmt.Fprintln(os.Stderr, "  --annotate=<path>   write the source annotated with execution counts")
+	fmt.Fprintln(os.Stderr, "  --html=<path>       write an HTML coverage report")
+	os.Exit(1)
+}
+
+// runProfiled executes the program while profiling it, then writes the
+// hot-line report to stderr and the folded stacks to the given path
+func runProfiled(e *evaluator.Evaluator, filename string, source string, path string) {
+	profiler := profile.NewProfiler(filename, source)
+	e.SetHooks(profiler.Hooks())
+
+	profiler.Start()
+	err := e.Execute()
+	profiler.Stop()
+
+	profiler.WriteReport(os.Stderr)
+	out, createErr := os.Create(path)
+	if createErr != nil {
+		fmt.Fprintf(os.Stderr, "Error creating profile file: %v\n", createErr)
+		os.Exit(1)
+	}
+	if writeErr := profiler.WriteFolded(out); writeErr != nil {
+		fmt.Fprintf(os.Stderr, "Error writing profile file: %v\n", writeErr)
+	}
+	out.Close()
+
+	evaluator.ExitOnError(err)
+}
+
+// runCoverage executes the program recording coverage, then prints a summary
+// to stderr and writes the requested annotated listing and HTML report
+func runCoverage(e *evaluator.Evaluator, p *parser.Parser, filename string, source string, htmlPath string, annotatePath string) {
+	p.EnableLineMarkers()
+	statements, ok := p.TryParseStatements()
+	if !ok {
+		os.Exit(65)
+	}
+
+	cov := coverage.NewCoverage(filename, source, statements)
+	e.SetHooks(cov.Hooks())
+	err := e.ExecuteStatements(statements)
+
+	cov.WriteSummary(os.Stderr)
+	writeReport(annotatePath, cov.WriteAnnotated)
+	writeReport(htmlPath, cov.WriteHTML)
+
+	evaluator.ExitOnError(err)
+}
+
+// writeReport creates the file at path and fills it with write.
+// An empty path means the report was not requested.
+func writeReport(path string, write func(w io.Writer)) {
+	if path == "" {
+		return
+	}
+
+	out, err := os.Create(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", path, err)
+		os.Exit(1)
+	}
+	write(out)
+	out.Close()
+}
+
+// runTests runs every *_test.mji file under paths and reports the results
+// on stdout, exiting with status 1 if any test did not pass
+func runTests(paths []string, format string, searchPath []string, mode evaluator.NumericMode, warnMatch bool) {
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	files, err := tester.Discover(paths)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error finding tests: %v\n", err)
+		os.Exit(1)
+	}
+
+	results := []tester.Result{}
+	for _, file := range files {
+		results = append(results, tester.RunFile(file, searchPath, mode, warnMatch)...)
+	}
+
+	switch format {
+	case "text":
+		tester.WriteText(os.Stdout, results)
+	case "tap":
+		tester.WriteTAP(os.Stdout, results)
+	case "junit":
+		if err := tester.WriteJUnit(os.Stdout, results); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing report: %v\n", err)
+			os.Exit(1)
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown test report format: %s\n", format)
+		os.Exit(1)
+	}
+
+	if _, failed, errored := tester.Summary(results); failed+errored > 0 {
+		os.Exit(1)
+	}
+}
+
+// compileArtifact parses and compiles the program and writes it, with its
+// source map, to an artifact that run can load without parsing
+func compileArtifact(p *parser.Parser, filename string, source string, path string, optimize bool, mode evaluator.NumericMode) {
+	statements := parseProgram(p)
+	if optimize {
+		statements = optimizer.Optimize(statements, mode)
+	}
+	chunk, err := vm.Compile(statements, mode)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(70)
+	}
+
+	program := &artifact.Program{
+		Filename:   filename,
+		Source:     source,
+		Statements: statements,
+		Chunk:      chunk,
+		Numeric:    mode,
+	}
+	writeReport(path, func(w io.Writer) {
+		if err := artifact.Write(w, program); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing artifact: %v\n", err)
+			os.Exit(1)
+		}
+	})
+}
+
+// runArtifact runs a compiled artifact on the evaluator or the VM
+func runArtifact(filename string, data []byte, useVM bool, limits evaluator.Limits, limited bool, modules *evaluator.ModuleLoader) {
+	program, err := artifact.Read(data)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading %s: %v\n", filename, err)
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if useVM {
+		machine := vm.NewVM(program.Chunk)
+		// Run in the mode its constants were folded in
+		machine.SetNumericMode(program.Numeric)
+		machine.SetModules(modules, filename)
+		if limited {
+			machine.SetLimits(limits)
+		}
+		evaluator.ExitOnError(machine.RunContext(ctx))
+		return
+	}
+
+	e := evaluator.NewEvaluator(nil)
+	e.SetNumericMode(program.Numeric)
+	e.SetModules(modules, filename)
+	if limited {
+		e.SetLimits(limits)
+	}
+	evaluator.ExitOnError(e.ExecuteStatementsContext(ctx, program.Statements))
+}
+
+// runBench runs the program repeatedly with its output discarded and
+// reports the time and allocations per run. Every run imports its modules
+// afresh.
+func runBench(p *parser.Parser, filename string, useVM bool, count int, searchPath []string, mode evaluator.NumericMode) {
+	if count < 1 {
+		count = 1
+	}
+
+	engine := "evaluator"
+	var run func() error
+	if useVM {
+		engine = "vm"
+		chunk := compileProgram(p, true, mode)
+		run = func() error {
+			machine := vm.NewVM(chunk)
+			machine.SetNumericMode(mode)
+			machine.SetModules(evaluator.NewModuleLoader(searchPath), filename)
+			machine.SetOutput(io.Discard)
+			return machine.Run()
+		}
+	} else {
+		statements := optimizer.Optimize(parseProgram(p), mode)
+		run = func() error {
+			e := evaluator.NewEvaluator(p)
+			e.SetNumericMode(mode)
+			e.SetModules(evaluator.NewModuleLoader(searchPath), filename)
+			e.SetOutput(io.Discard)
+			return e.ExecuteStatements(statements)
+		}
+	}
+
+	var total, fastest time.Duration
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	for i := 0; i < count; i++ {
+		start := time.Now()
+		evaluator.ExitOnError(run())
+		elapsed := time.Since(start)
+		total += elapsed
+		if i == 0 || elapsed < fastest {
+			fastest = elapsed
+		}
+	}
+	runtime.ReadMemStats(&after)
+
+	fmt.Printf("%s (%s, %d runs)\n", filename, engine, count)
+	fmt.Printf("  min     %v\n", fastest)
+	fmt.Printf("  mean    %v\n", total/time.Duration(count))
+	fmt.Printf("  allocs  %d per run\n", (after.Mallocs-before.Mallocs)/uint64(count))
+}
+
+// newParser creates a parser for a program's tokens, warning about match
+// statements that may match no arm if warnMatch is set
+func newParser(tokens []types.Token, warnMatch bool) *parser.Parser {
+	p := parser.NewParser(tokens)
+	if warnMatch {
+		p.EnableMatchWarnings()
+	}
+	return p
+}
+
+// parseProgram parses the program with line markers, exiting with code 65
+// on syntax errors
+func parseProgram(p *parser.Parser) []string {
+	p.EnableLineMarkers()
+	statements, ok := p.TryParseStatements()
+	if !ok {
+		os.Exit(65)
+	}
+	return statements
+}
+
+// compileProgram parses the program, optionally optimizes it and compiles
+// it to bytecode for the numeric mode
+func compileProgram(p *parser.Parser, optimize bool, mode evaluator.NumericMode) *vm.Chunk {
+	statements := parseProgram(p)
+	if optimize {
+		statements = optimizer.Optimize(statements, mode)
+	}
+
+	chunk, err := vm.Compile(statements, mode)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(70)
+	}
+	return chunk
+}
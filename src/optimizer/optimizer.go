@@ -0,0 +1,341 @@
+package optimizer
+
+import (
+	"strconv"
+
+	"moji/src/evaluator"
+	"moji/src/sexpr"
+)
+
+// Optimize folds constant expressions and removes statically dead branches
+// and loops from parsed statements. Expressions that would fail at runtime
+// are left alone so the error still happens when, and only if, they run.
+// Constants are folded in mode, which the statements must also run in.
+func Optimize(statements []string, mode evaluator.NumericMode) []string {
+	o := &optimizer{mode: mode}
+	optimized := make([]string, 0, len(statements))
+	for _, stmt := range statements {
+		n, err := sexpr.Read(stmt)
+		if err != nil {
+			// Leave anything we cannot read for the evaluator to report
+			optimized = append(optimized, stmt)
+			continue
+		}
+		if n = o.statement(n); n != nil {
+			optimized = append(optimized, n.String())
+		}
+	}
+	return optimized
+}
+
+// optimizer holds the numeric mode constants are folded in
+type optimizer struct {
+	mode evaluator.NumericMode
+}
+
+// statement optimizes a statement, returning nil if it can be removed
+func (o *optimizer) statement(n *sexpr.Node) *sexpr.Node {
+	switch n.Head() {
+	case "at":
+		if len(n.List) != 3 {
+			return n
+		}
+		inner := o.statement(n.List[2])
+		// A branch that replaced its if statement keeps its own line marker
+		if inner == nil || inner.Head() == "at" {
+			return inner
+		}
+		n.List[2] = inner
+		return n
+	case "block":
+		body := []*sexpr.Node{n.List[0]}
+		for _, stmt := range n.List[1:] {
+			if stmt = o.statement(stmt); stmt != nil {
+				body = append(body, stmt)
+			}
+		}
+		n.List = body
+		return n
+	case "if":
+		if len(n.List) != 3 && len(n.List) != 4 {
+			return n
+		}
+		condition := o.expression(n.List[1])
+		if value, ok := o.constantValue(condition); ok {
+			if evaluator.IsTruthy(value) {
+				return o.statement(n.List[2])
+			}
+			if len(n.List) == 4 {
+				return o.statement(n.List[3])
+			}
+			return nil
+		}
+		n.List[1] = condition
+		n.List[2] = keepStatement(o.statement(n.List[2]))
+		if len(n.List) == 4 {
+			n.List[3] = keepStatement(o.statement(n.List[3]))
+		}
+		return n
+	case "while":
+		if len(n.List) != 3 && len(n.List) != 4 {
+			return n
+		}
+		condition := o.expression(n.List[1])
+		if value, ok := o.constantValue(condition); ok && !evaluator.IsTruthy(value) {
+			return nil
+		}
+		n.List[1] = condition
+		n.List[2] = keepStatement(o.statement(n.List[2]))
+		if len(n.List) == 4 {
+			n.List[3] = o.expression(n.List[3])
+		}
+		return n
+	case "break", "continue":
+		return n
+	case "try":
+		if len(n.List) < 2 {
+			return n
+		}
+		n.List[1] = keepStatement(o.statement(n.List[1]))
+		for _, clause := range n.List[2:] {
+			if last := len(clause.List) - 1; (clause.Head() == "catch" || clause.Head() == "finally") && last > 0 {
+				clause.List[last] = keepStatement(o.statement(clause.List[last]))
+			}
+		}
+		return n
+	case "export":
+		if len(n.List) == 2 {
+			n.List[1] = keepStatement(o.statement(n.List[1]))
+		}
+		return n
+	case "throw":
+		if len(n.List) == 2 {
+			n.List[1] = o.expression(n.List[1])
+		}
+		return n
+	case "for-each":
+		if len(n.List) != 4 {
+			return n
+		}
+		n.List[2] = o.expression(n.List[2])
+		n.List[3] = keepStatement(o.statement(n.List[3]))
+		return n
+	case "match":
+		if len(n.List) < 2 {
+			return n
+		}
+		n.List[1] = o.expression(n.List[1])
+		for _, arm := range n.List[2:] {
+			if arm.Head() != "arm" || len(arm.List) != 4 {
+				continue
+			}
+			for _, pattern := range arm.List[1].List {
+				if pattern.Head() == "is" || pattern.Head() == "range" {
+					for i := 1; i < len(pattern.List); i++ {
+						pattern.List[i] = o.expression(pattern.List[i])
+					}
+				}
+			}
+			arm.List[2] = o.expression(arm.List[2])
+			arm.List[3] = keepStatement(o.statement(arm.List[3]))
+		}
+		return n
+	case "print":
+		if len(n.List) == 2 {
+			n.List[1] = o.expression(n.List[1])
+		}
+		return n
+	case "var", "const":
+		if len(n.List) == 3 {
+			n.List[2] = o.expression(n.List[2])
+		}
+		return n
+	case "test":
+		if len(n.List) == 3 {
+			n.List[2] = keepStatement(o.statement(n.List[2]))
+		}
+		return n
+	case "assert":
+		for i := 2; i < len(n.List); i++ {
+			// An equality keeps its operands apart so a failure reports both
+			if i == 2 && n.List[i].Head() == "==" && len(n.List[i].List) == 3 {
+				equality := n.List[i]
+				equality.List[1] = o.expression(equality.List[1])
+				equality.List[2] = o.expression(equality.List[2])
+				continue
+			}
+			n.List[i] = o.expression(n.List[i])
+		}
+		return n
+	}
+
+	return o.expression(n)
+}
+
+// keepStatement replaces a removed statement that must still exist, such as
+// the body of a loop, with an empty block
+func keepStatement(n *sexpr.Node) *sexpr.Node {
+	if n == nil {
+		return &sexpr.Node{IsList: true, List: []*sexpr.Node{{Atom: "block"}}}
+	}
+	return n
+}
+
+var binaryOperators = map[string]bool{
+	"+": true, "-": true, "*": true, "/": true, "%": true, "~/": true, "**": true,
+	"==": true, "!=": true, ">": true, ">=": true, "<": true, "<=": true,
+}
+
+// expression folds the constant parts of an expression
+func (o *optimizer) expression(n *sexpr.Node) *sexpr.Node {
+	if !n.IsList || len(n.List) == 0 {
+		return n
+	}
+
+	operator := n.Head()
+	switch operator {
+	case "var-ref":
+		return n
+	case "assign":
+		if len(n.List) == 4 {
+			n.List[3] = o.expression(n.List[3])
+		}
+		return n
+	case "group":
+		if len(n.List) != 2 {
+			return n
+		}
+		inner := o.expression(n.List[1])
+		if _, ok := o.constantValue(inner); ok {
+			return inner
+		}
+		n.List[1] = inner
+		return n
+	case "and", "or":
+		if len(n.List) != 3 {
+			return n
+		}
+		left := o.expression(n.List[1])
+		right := o.expression(n.List[2])
+		if value, ok := o.constantValue(left); ok {
+			// The left operand decides whether the right one is evaluated
+			if evaluator.IsTruthy(value) == (operator == "or") {
+				return left
+			}
+			return right
+		}
+		n.List[1], n.List[2] = left, right
+		return n
+	case "?":
+		if len(n.List) != 4 {
+			return n
+		}
+		for i := 1; i < len(n.List); i++ {
+			n.List[i] = o.expression(n.List[i])
+		}
+		if value, ok := o.constantValue(n.List[1]); ok {
+			if evaluator.IsTruthy(value) {
+				return n.List[2]
+			}
+			return n.List[3]
+		}
+		return n
+	case "??":
+		if len(n.List) != 3 {
+			return n
+		}
+		left := o.expression(n.List[1])
+		right := o.expression(n.List[2])
+		if value, ok := o.constantValue(left); ok {
+			if value == "nil" {
+				return right
+			}
+			return left
+		}
+		n.List[1], n.List[2] = left, right
+		return n
+	}
+
+	for i := 1; i < len(n.List); i++ {
+		n.List[i] = o.expression(n.List[i])
+	}
+
+	switch {
+	case len(n.List) == 2 && (operator == "-" || operator == "!"):
+		value, ok := o.constantValue(n.List[1])
+		if !ok || (operator == "-" && !isNumber(value)) {
+			return n
+		}
+		result, err := evaluator.UnaryOp(o.mode, operator, value)
+		if err != nil {
+			return n
+		}
+		return literal(result)
+	case len(n.List) == 3 && binaryOperators[operator]:
+		left, leftOk := o.constantValue(n.List[1])
+		right, rightOk := o.constantValue(n.List[2])
+		if !leftOk || !rightOk || !canFold(operator, left, right) {
+			return n
+		}
+		result, err := evaluator.BinaryOp(o.mode, operator, left, right)
+		if err != nil {
+			return n
+		}
+		return literal(result)
+	}
+	return n
+}
+
+// canFold reports whether applying the operator is certain to succeed, so
+// runtime errors and their diagnostics are never produced while folding
+func canFold(operator string, left, right string) bool {
+	switch operator {
+	case "==", "!=":
+		return true
+	case "+":
+		return (isNumber(left) && isNumber(right)) || (isString(left) && isString(right))
+	case "/", "%", "~/":
+		if isNumber(right) {
+			if divisor, _ := strconv.ParseFloat(right, 64); divisor == 0 {
+				return false
+			}
+		}
+	}
+	return isNumber(left) && isNumber(right)
+}
+
+// constantValue returns the evaluated value of a literal node
+func (o *optimizer) constantValue(n *sexpr.Node) (string, bool) {
+	if n.IsString {
+		return "\"" + n.Text + "\"", true
+	}
+	if n.IsList {
+		return "", false
+	}
+
+	switch n.Atom {
+	case "true", "false", "nil":
+		return n.Atom, true
+	}
+	if num, ok := evaluator.NormalizeNumber(o.mode, n.Atom); ok {
+		return num, true
+	}
+	return "", false
+}
+
+// literal turns an evaluated value back into an expression
+func literal(value string) *sexpr.Node {
+	if isString(value) {
+		return &sexpr.Node{IsString: true, Text: value[1 : len(value)-1]}
+	}
+	return &sexpr.Node{Atom: value}
+}
+
+func isNumber(value string) bool {
+	_, err := strconv.ParseFloat(value, 64)
+	return err == nil
+}
+
+func isString(value string) bool {
+	return len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"'
+}
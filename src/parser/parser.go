@@ -7,12 +7,18 @@ import (
 
 	"moji/src/scanner/constants"
 	"moji/src/scanner/types"
+	"moji/src/sexpr"
 )
 
 type Parser struct {
 	tokens  []types.Token
 	current int
 	hadError bool
+	lineMarkers bool
+	matchWarnings bool // Warn about match statements without a catch-all arm
+	statementDepth int
+	loopDepth int // Number of loops around the statement being parsed
+	scopes []map[string]bool // Names declared in each scope, globals first; true for constants
 }
 
 func NewParser(tokens []types.Token) *Parser {
@@ -20,6 +26,7 @@ func NewParser(tokens []types.Token) *Parser {
 		tokens:  tokens,
 		current: 0,
 		hadError: false,
+		scopes:  []map[string]bool{{}},
 	}
 }
 
@@ -31,8 +38,41 @@ func (p *Parser) Parse() string {
 	return expr
 }
 
-// Parse a list of statements
+// ParseExpression parses a single expression without exiting on syntax errors.
+// The second result is false if the expression could not be parsed.
+func (p *Parser) ParseExpression() (string, bool) {
+	expr := p.expression()
+	if !p.isAtEnd() && !p.check(constants.SEMICOLON) {
+		p.error(p.peek(), "Expect end of expression.")
+	}
+	return expr, !p.hadError
+}
+
+// EnableLineMarkers makes the parser wrap every statement as (at <line> <stmt>)
+// so the evaluator knows which source line it is executing.
+func (p *Parser) EnableLineMarkers() {
+	p.lineMarkers = true
+}
+
+// EnableMatchWarnings makes the parser warn about match statements that
+// may match no arm
+func (p *Parser) EnableMatchWarnings() {
+	p.matchWarnings = true
+}
+
+// Parse a list of statements, exiting with code 65 on syntax errors
 func (p *Parser) ParseStatements() []string {
+	statements, ok := p.TryParseStatements()
+	if !ok {
+		os.Exit(65)
+	}
+	return statements
+}
+
+// TryParseStatements parses a list of statements without exiting the process.
+// Syntax errors are still reported to stderr; the second result is false if
+// any were found.
+func (p *Parser) TryParseStatements() ([]string, bool) {
 	statements := []string{}
 	
 	for !p.isAtEnd() {
@@ -46,15 +86,22 @@ func (p *Parser) ParseStatements() []string {
 		}
 	}
 	
-	if p.hadError {
-		os.Exit(65)
-	}
-	
-	return statements
+	return statements, !p.hadError
 }
 
-// Parse a single statement
+// Parse a single statement, wrapping it in a line marker if enabled
 func (p *Parser) statement() string {
+	line := p.peek().Line
+	p.statementDepth++
+	stmt := p.unmarkedStatement()
+	p.statementDepth--
+	if p.lineMarkers && stmt != "" {
+		return fmt.Sprintf("(at %d %s)", line, stmt)
+	}
+	return stmt
+}
+
+func (p *Parser) unmarkedStatement() string {
 	if p.match(constants.PRINT) {
 		return p.printStatement()
 	}
@@ -63,6 +110,10 @@ func (p *Parser) statement() string {
 		return p.varDeclaration()
 	}
 	
+	if p.match(constants.CONST) {
+		return p.constDeclaration()
+	}
+	
 	if p.match(constants.LEFT_BRACE) {
 		return p.blockStatement()
 	}
@@ -79,6 +130,42 @@ func (p *Parser) statement() string {
 		return p.forStatement()
 	}
 	
+	if p.match(constants.EACH) {
+		return p.forEachStatement()
+	}
+	
+	if p.match(constants.MATCH) {
+		return p.matchStatement()
+	}
+	
+	if p.match(constants.IMPORT) {
+		return p.importStatement()
+	}
+	
+	if p.match(constants.EXPORT) {
+		return p.exportStatement()
+	}
+	
+	if p.match(constants.TRY) {
+		return p.tryStatement()
+	}
+	
+	if p.match(constants.THROW) {
+		return p.throwStatement()
+	}
+	
+	if p.match(constants.BREAK) || p.match(constants.CONTINUE) {
+		return p.loopControlStatement()
+	}
+	
+	if p.match(constants.TEST) {
+		return p.testStatement()
+	}
+	
+	if p.match(constants.ASSERT) {
+		return p.assertStatement()
+	}
+	
 	// If it's not a print statement, treat it as an expression statement
 	return p.expressionStatement()
 }
@@ -120,13 +207,67 @@ func (p *Parser) varDeclaration() string {
 	}
 	
 	p.consume(constants.SEMICOLON, "Expect ';' after variable declaration.")
+	p.declare(name, false)
 	
 	return fmt.Sprintf("(var %s %s)", name.Lexeme, initializer)
 }
 
+// Parse a constant declaration: "🔒" IDENTIFIER "=" expression ";"
+func (p *Parser) constDeclaration() string {
+	name := p.consume(constants.IDENTIFIER, "Expect constant name.")
+	if !p.match(constants.EQUAL) {
+		p.error(p.peek(), "Expect '=' after constant name.")
+		p.synchronize()
+		return ""
+	}
+	initializer := p.expression()
+	p.consume(constants.SEMICOLON, "Expect ';' after constant declaration.")
+	p.declare(name, true)
+	
+	return fmt.Sprintf("(const %s %s)", name.Lexeme, initializer)
+}
+
+// declare records a name declared in the current scope, so assignments to
+// constants can be rejected before the program runs
+func (p *Parser) declare(name types.Token, constant bool) {
+	scope := p.scopes[len(p.scopes)-1]
+	if scope[name.Lexeme] {
+		p.error(name, fmt.Sprintf("Can't redeclare constant '%s'.", name.Lexeme))
+		return
+	}
+	scope[name.Lexeme] = constant
+}
+
+// beginScope opens a scope holding the given names, none of them constant
+func (p *Parser) beginScope(names ...string) {
+	scope := map[string]bool{}
+	for _, name := range names {
+		scope[name] = false
+	}
+	p.scopes = append(p.scopes, scope)
+}
+
+func (p *Parser) endScope() {
+	p.scopes = p.scopes[:len(p.scopes)-1]
+}
+
+// isConstant reports whether a name refers to a constant declared in one of
+// the scopes around the current position. Names declared nowhere are
+// checked when the program runs.
+func (p *Parser) isConstant(name string) bool {
+	for i := len(p.scopes) - 1; i >= 0; i-- {
+		if constant, ok := p.scopes[i][name]; ok {
+			return constant
+		}
+	}
+	return false
+}
+
 // Parse a block statement: "{" statement* "}"
 func (p *Parser) blockStatement() string {
 	statements := []string{}
+	p.beginScope()
+	defer p.endScope()
 	
 	for !p.check(constants.RIGHT_BRACE) && !p.isAtEnd() {
 		stmt := p.statement()
@@ -165,7 +306,7 @@ func (p *Parser) synchronize() {
 		}
 
 		switch p.peek().TokenType {
-		case constants.CLASS, constants.FUN, constants.VAR, constants.FOR, constants.IF, constants.WHILE, constants.PRINT, constants.RETURN:
+		case constants.CLASS, constants.FUN, constants.VAR, constants.CONST, constants.FOR, constants.EACH, constants.IF, constants.WHILE, constants.PRINT, constants.RETURN, constants.TRY, constants.THROW, constants.IMPORT, constants.EXPORT:
 			return
 		}
 
@@ -307,14 +448,19 @@ func (p *Parser) term() string {
 func (p *Parser) factor() string {
 	expr := p.unary()
 
-	for !p.isAtEnd() && (p.match(constants.STAR) || p.match(constants.SLASH)) {
+	for !p.isAtEnd() && (p.match(constants.STAR) || p.match(constants.SLASH) || p.match(constants.PERCENT) || p.match(constants.TILDE_SLASH)) {
 		operator := p.previous().TokenType
 		right := p.unary()
 
-		if operator == constants.STAR {
+		switch operator {
+		case constants.STAR:
 			expr = fmt.Sprintf("(* %s %s)", expr, right)
-		} else {
+		case constants.SLASH:
 			expr = fmt.Sprintf("(/ %s %s)", expr, right)
+		case constants.PERCENT:
+			expr = fmt.Sprintf("(%% %s %s)", expr, right)
+		default:
+			expr = fmt.Sprintf("(~/ %s %s)", expr, right)
 		}
 	}
 
@@ -336,7 +482,136 @@ func (p *Parser) unary() string {
 		return fmt.Sprintf("(- %s)", right)
 	}
 
-	return p.primary()
+	return p.exponent()
+}
+
+// exponent binds tighter than unary minus, so -2 ** 2 is -(2 ** 2), and
+// groups to the right, so 2 ** 3 ** 2 is 2 ** (3 ** 2)
+func (p *Parser) exponent() string {
+	expr := p.call()
+
+	if !p.isAtEnd() && p.match(constants.STAR_STAR) {
+		right := p.unary()
+		expr = fmt.Sprintf("(** %s %s)", expr, right)
+	}
+
+	return expr
+}
+
+// call parses calls to built-in functions and indexing, which bind tighter
+// than any operator: name(args), xs[i] and xs[start:end]
+func (p *Parser) call() string {
+	expr := p.primary()
+
+	for !p.isAtEnd() {
+		if p.match(constants.LEFT_PAREN) {
+			expr = p.finishCall(expr)
+		} else if p.match(constants.LEFT_BRACKET) {
+			expr = p.finishIndex(expr)
+		} else {
+			break
+		}
+	}
+
+	return expr
+}
+
+// finishCall parses the arguments of a call into (call <name> <line> <args>...)
+func (p *Parser) finishCall(callee string) string {
+	paren := p.previous()
+	arguments := []string{}
+	if !p.check(constants.RIGHT_PAREN) {
+		for {
+			if len(arguments) >= 255 {
+				p.error(p.peek(), "Can't have more than 255 arguments.")
+			}
+			arguments = append(arguments, p.expression())
+			if !p.match(constants.COMMA) {
+				break
+			}
+		}
+	}
+	p.consume(constants.RIGHT_PAREN, "Expect ')' after arguments.")
+
+	// Only built-in functions can be called, and they are called by name
+	if !strings.HasPrefix(callee, "(var-ref ") {
+		p.error(paren, "Can only call functions.")
+		return ""
+	}
+	name := strings.TrimSuffix(strings.TrimPrefix(callee, "(var-ref "), ")")
+	return "(call " + strings.Join(append([]string{name}, arguments...), " ") + ")"
+}
+
+// finishIndex parses xs[i] into (index xs i) and xs[start:end] into
+// (slice xs start end), where a missing bound is nil
+func (p *Parser) finishIndex(target string) string {
+	start := "nil"
+	if !p.check(constants.COLON) {
+		start = p.expression()
+	}
+
+	if p.match(constants.COLON) {
+		end := "nil"
+		if !p.check(constants.RIGHT_BRACKET) {
+			end = p.expression()
+		}
+		p.consume(constants.RIGHT_BRACKET, "Expect ']' after slice.")
+		return fmt.Sprintf("(slice %s %s %s)", target, start, end)
+	}
+
+	p.consume(constants.RIGHT_BRACKET, "Expect ']' after index.")
+	return fmt.Sprintf("(index %s %s)", target, start)
+}
+
+// listLiteral parses the elements of [a, b, c] into (list a b c)
+func (p *Parser) listLiteral() string {
+	elements := []string{"list"}
+	for !p.check(constants.RIGHT_BRACKET) && !p.isAtEnd() {
+		elements = append(elements, p.expression())
+		if !p.match(constants.COMMA) {
+			break
+		}
+	}
+	p.consume(constants.RIGHT_BRACKET, "Expect ']' after list elements.")
+	return "(" + strings.Join(elements, " ") + ")"
+}
+
+// mapLiteral parses the entries of 🗺️ {key: value, ...} into
+// (map key value ...); the emoji keeps it apart from a block
+func (p *Parser) mapLiteral() string {
+	p.consume(constants.LEFT_BRACE, "Expect '{' after '🗺️'.")
+	entries := []string{"map"}
+	for !p.check(constants.RIGHT_BRACE) && !p.isAtEnd() {
+		key := p.expression()
+		p.consume(constants.COLON, "Expect ':' after map key.")
+		entries = append(entries, key, p.expression())
+		if !p.match(constants.COMMA) {
+			break
+		}
+	}
+	p.consume(constants.RIGHT_BRACE, "Expect '}' after map entries.")
+	return "(" + strings.Join(entries, " ") + ")"
+}
+
+// indexAssignment turns xs[i][j] = value into
+// (set-index (var-ref xs <line>) i j value), or reports false when the
+// target is not an index into a variable
+func indexAssignment(target, value string) (string, bool) {
+	n, err := sexpr.Read(target)
+	if err != nil {
+		return "", false
+	}
+
+	indices := []string{}
+	for n.Head() == "index" && len(n.List) == 3 {
+		indices = append([]string{n.List[2].String()}, indices...)
+		n = n.List[1]
+	}
+	if len(indices) == 0 || n.Head() != "var-ref" {
+		return "", false
+	}
+
+	return fmt.Sprintf("(set-index %s %s %s)", n, strings.Join(indices, " "), value), true
 }
 
 func (p *Parser) primary() string {
@@ -363,10 +638,20 @@ func (p *Parser) primary() string {
 		return token.Literal.(string)
 	case constants.STRING:
 		// Mark string literals explicitly with a prefix to differentiate them from number literals
-		return "(string " + token.Literal.(string) + ")"
+		return sexpr.StringLiteral(token.Literal.(string))
 	case constants.IDENTIFIER:
+		// Module members are named with their module: strings.upper
+		name := token.Lexeme
+		for p.check(constants.DOT) && p.checkNext(constants.IDENTIFIER) {
+			p.advance()
+			name += "." + p.advance().Lexeme
+		}
 		// Include the line number with the variable reference
-		return fmt.Sprintf("(var-ref %s %d)", token.Lexeme, token.Line)
+		return fmt.Sprintf("(var-ref %s %d)", name, token.Line)
+	case constants.LEFT_BRACKET:
+		return p.listLiteral()
+	case constants.MAP:
+		return p.mapLiteral()
 	case constants.LEFT_PAREN:
 		// Check for empty parentheses
 		if p.check(constants.RIGHT_PAREN) {
@@ -399,7 +684,7 @@ func (p *Parser) isAtEnd() bool {
 }
 
 func (p *Parser) assignment() string {
-	expr := p.logicalOr()
+	expr := p.conditional()
 	
 	if p.match(constants.EQUAL) {
 		equals := p.previous()
@@ -414,14 +699,26 @@ func (p *Parser) assignment() string {
 			content = strings.TrimSuffix(content, ")")
 			
 			parts := strings.Split(content, " ")
-			if len(parts) >= 2 {
+			// Module members can't be assigned
+			if len(parts) >= 2 && !strings.Contains(parts[0], ".") {
 				varName := parts[0]
 				line := parts[1]
+				if p.isConstant(varName) {
+					p.error(equals, fmt.Sprintf("Can't assign to constant '%s'.", varName))
+				}
 				
 				// Create an assignment expression
 				return fmt.Sprintf("(assign %s %s %s)", varName, line, value)
 			}
 		}
+
+		// Assigning to an element stores the updated list in its variable
+		if assignment, ok := indexAssignment(expr, value); ok {
+			if name := strings.Fields(strings.TrimPrefix(assignment, "(set-index (var-ref "))[0]; p.isConstant(name) {
+				p.error(equals, fmt.Sprintf("Can't assign to constant '%s'.", name))
+			}
+			return assignment
+		}
 		
 		p.error(equals, "Invalid assignment target.")
 	}
@@ -429,6 +726,33 @@ func (p *Parser) assignment() string {
 	return expr
 }
 
+// conditional parses cond ❓ a ❗ b, which groups to the right so that
+// a ❓ b ❗ c ❓ d ❗ e chooses between b and (c ❓ d ❗ e)
+func (p *Parser) conditional() string {
+	expr := p.coalesce()
+
+	if p.match(constants.QUESTION) {
+		thenBranch := p.expression()
+		p.consume(constants.COLON, "Expect ':' after then branch of conditional expression.")
+		elseBranch := p.conditional()
+		expr = fmt.Sprintf("(? %s %s %s)", expr, thenBranch, elseBranch)
+	}
+
+	return expr
+}
+
+// coalesce parses x 🤷 default, which only evaluates default when x is nil
+func (p *Parser) coalesce() string {
+	expr := p.logicalOr()
+
+	for p.match(constants.QUESTION_QUESTION) {
+		right := p.logicalOr()
+		expr = fmt.Sprintf("(?? %s %s)", expr, right)
+	}
+
+	return expr
+}
+
 func (p *Parser) logicalOr() string {
 	expr := p.logicalAnd()
 	
@@ -462,7 +786,7 @@ func (p *Parser) ifStatement() string {
 	var elseBranch string
 	if p.match(constants.ELSE) {
 		// In the else branch, we expect a statement, not a declaration
-		if p.check(constants.VAR) {
+		if p.check(constants.VAR) || p.check(constants.CONST) {
 			p.error(p.peek(), "Expect expression.")
 			p.synchronize()
 			return ""
@@ -481,7 +805,7 @@ func (p *Parser) whileStatement() string {
 	condition := p.expression()
 	p.consume(constants.RIGHT_PAREN, "Expect ')' after while condition.")
 	
-	body := p.statement()
+	body := p.loopBody()
 	
 	return fmt.Sprintf("(while %s %s)", condition, body)
 }
@@ -489,6 +813,9 @@ func (p *Parser) whileStatement() string {
 // Parse a for statement: "for" "(" (varDecl | exprStmt | ";") expression? ";" expression? ")" statement
 func (p *Parser) forStatement() string {
 	p.consume(constants.LEFT_PAREN, "Expect '(' after 'for'.")
+	// The initializer's variable lives in a block around the loop
+	p.beginScope()
+	defer p.endScope()
 	
 	// Parse initializer
 	var initializer string
@@ -535,18 +862,18 @@ func (p *Parser) forStatement() string {
 	}
 	
 	// Parse body
-	body := p.statement()
+	body := p.loopBody()
 	
 	// Desugar for loop into a while loop with a block
 	
-	// If there's an increment, make the body a block containing the original body and the increment
+	// If there's an increment, the while loop runs it after the body, even
+	// when the body continues early. The body keeps a block of its own.
 	if increment != "" {
-		body = fmt.Sprintf("(block %s %s)", body, increment)
+		body = fmt.Sprintf("(while %s (block %s) %s)", condition, body, increment)
+	} else {
+		body = fmt.Sprintf("(while %s %s)", condition, body)
 	}
 	
-	// Create the while loop with the condition
-	body = fmt.Sprintf("(while %s %s)", condition, body)
-	
 	// If there's an initializer, make the loop a block containing the initializer and the while loop
 	if initializer != "" {
 		body = fmt.Sprintf("(block %s %s)", initializer, body)
@@ -554,3 +881,309 @@ func (p *Parser) forStatement() string {
 	
 	return body
 }
+
+// Parse a for-each loop: "🔁" IDENTIFIER ("," IDENTIFIER)? "in" iterable
+// statement, where the iterable is an expression or a range
+// start ".." end ("step" step)?. It becomes (for-each (<names>) <iterable>
+// <body>), with ranges as (range <start> <end> <step>).
+func (p *Parser) forEachStatement() string {
+	hadError := p.hadError
+	names := []string{p.consume(constants.IDENTIFIER, "Expect loop variable name.").Lexeme}
+	if p.match(constants.COMMA) {
+		second := p.consume(constants.IDENTIFIER, "Expect second loop variable name.")
+		if second.Lexeme == names[0] {
+			p.error(second, "Loop variables must have different names.")
+		}
+		names = append(names, second.Lexeme)
+	}
+	if !p.matchWord("in") {
+		p.error(p.peek(), "Expect 'in' after loop variables.")
+		return ""
+	}
+
+	iterable := p.expression()
+	if p.match(constants.DOT_DOT) {
+		end := p.expression()
+		step := "1.0"
+		if p.matchWord("step") {
+			step = p.expression()
+		}
+		iterable = fmt.Sprintf("(range %s %s %s)", iterable, end, step)
+	}
+	if p.hadError && !hadError {
+		return ""
+	}
+
+	p.beginScope(names...)
+	body := p.loopBody()
+	p.endScope()
+	return fmt.Sprintf("(for-each (%s) %s %s)", strings.Join(names, " "), iterable, body)
+}
+
+// loopBody parses the body of a loop, where break and continue are allowed
+func (p *Parser) loopBody() string {
+	p.loopDepth++
+	defer func() { p.loopDepth-- }()
+	return p.statement()
+}
+
+// Parse an import: "📥" STRING "as" IDENTIFIER ";", which becomes
+// (import <path> <name>)
+func (p *Parser) importStatement() string {
+	keyword := p.previous()
+	if p.statementDepth > 1 {
+		p.error(keyword, "Imports must be at the top level.")
+	}
+	if !p.check(constants.STRING) {
+		p.error(p.peek(), fmt.Sprintf("Expect module path after '%s'.", keyword.Lexeme))
+		return ""
+	}
+	path := p.primary()
+	if !p.matchWord("as") {
+		p.error(p.peek(), "Expect 'as' after module path.")
+		return ""
+	}
+	name := p.consume(constants.IDENTIFIER, "Expect module name after 'as'.")
+	p.consume(constants.SEMICOLON, "Expect ';' after import.")
+	
+	return fmt.Sprintf("(import %s %s)", path, name.Lexeme)
+}
+
+// Parse an export: "📤" (varDecl | constDecl), which becomes
+// (export <declaration>). Only exported names can be used by the modules
+// that import this one.
+func (p *Parser) exportStatement() string {
+	keyword := p.previous()
+	if p.statementDepth > 1 {
+		p.error(keyword, "Exports must be at the top level.")
+	}
+	var declaration string
+	switch {
+	case p.match(constants.VAR):
+		declaration = p.varDeclaration()
+	case p.match(constants.CONST):
+		declaration = p.constDeclaration()
+	default:
+		p.error(p.peek(), fmt.Sprintf("Expect declaration after '%s'.", keyword.Lexeme))
+		return ""
+	}
+	if declaration == "" {
+		return ""
+	}
+	
+	return fmt.Sprintf("(export %s)", declaration)
+}
+
+// Parse a try statement: "🛟" block ("🥅" "(" IDENTIFIER ")" block)?
+// ("🧹" block)?, with at least one of the clauses. It becomes (try <block>
+// (catch <name> <block>)? (finally <block>)?).
+func (p *Parser) tryStatement() string {
+	keyword := p.previous()
+	p.consume(constants.LEFT_BRACE, fmt.Sprintf("Expect '{' after '%s'.", keyword.Lexeme))
+	body := p.blockStatement()
+	clauses := []string{body}
+
+	if p.match(constants.CATCH) {
+		catch := p.previous()
+		p.consume(constants.LEFT_PAREN, fmt.Sprintf("Expect '(' after '%s'.", catch.Lexeme))
+		name := p.consume(constants.IDENTIFIER, "Expect error variable name.")
+		p.consume(constants.RIGHT_PAREN, "Expect ')' after error variable name.")
+		p.consume(constants.LEFT_BRACE, "Expect '{' before catch body.")
+		// The caught value has a scope of its own around the block
+		p.beginScope(name.Lexeme)
+		handler := p.blockStatement()
+		p.endScope()
+		clauses = append(clauses, fmt.Sprintf("(catch %s %s)", name.Lexeme, handler))
+	}
+	if p.match(constants.FINALLY) {
+		p.consume(constants.LEFT_BRACE, fmt.Sprintf("Expect '{' after '%s'.", p.previous().Lexeme))
+		clauses = append(clauses, fmt.Sprintf("(finally %s)", p.blockStatement()))
+	}
+	if len(clauses) == 1 {
+		p.error(p.peek(), fmt.Sprintf("Expect '🥅' or '🧹' after '%s' block.", keyword.Lexeme))
+		return ""
+	}
+
+	return fmt.Sprintf("(try %s)", strings.Join(clauses, " "))
+}
+
+// Parse a throw statement: "🚨" expression ";"
+func (p *Parser) throwStatement() string {
+	value := p.expression()
+	p.consume(constants.SEMICOLON, "Expect ';' after thrown value.")
+
+	return fmt.Sprintf("(throw %s)", value)
+}
+
+// Parse a break or continue statement: ("break" | "continue") ";"
+func (p *Parser) loopControlStatement() string {
+	keyword := p.previous()
+	p.consume(constants.SEMICOLON, fmt.Sprintf("Expect ';' after '%s'.", keyword.Lexeme))
+	
+	if p.loopDepth == 0 {
+		p.error(keyword, fmt.Sprintf("Can't use '%s' outside of a loop.", keyword.Lexeme))
+		return ""
+	}
+	if keyword.TokenType == constants.BREAK {
+		return "(break)"
+	}
+	return "(continue)"
+}
+
+// matchWord consumes an identifier that acts as a keyword in one place
+// only, like the "in" of a for-each loop, so it stays usable as a name
+func (p *Parser) matchWord(word string) bool {
+	if p.check(constants.IDENTIFIER) && p.peek().Lexeme == word {
+		p.advance()
+		return true
+	}
+	return false
+}
+
+// Parse a match statement: "🎯" "(" expression ")" "{" arm* "}", where an
+// arm is pattern ("," pattern)* ("🔀" guard)? "=>" statement. It becomes
+// (match <value> <arm>...) with each arm as (arm (<pattern>...) <guard>
+// <body>). Patterns are (is <literal>), (range <low> <high>), (bind <name>)
+// or _ for the wildcard.
+func (p *Parser) matchStatement() string {
+	keyword := p.previous()
+	p.consume(constants.LEFT_PAREN, "Expect '(' after '🎯'.")
+	value := p.expression()
+	p.consume(constants.RIGHT_PAREN, "Expect ')' after match value.")
+	p.consume(constants.LEFT_BRACE, "Expect '{' before match arms.")
+
+	arms := []string{}
+	exhaustive := false
+	hadError := p.hadError
+	for !p.check(constants.RIGHT_BRACE) && !p.isAtEnd() {
+		patterns := []string{p.pattern()}
+		for p.match(constants.COMMA) {
+			patterns = append(patterns, p.pattern())
+		}
+		if len(patterns) > 1 && strings.Contains(strings.Join(patterns, " "), "(bind ") {
+			p.error(p.previous(), "A binding pattern can't have alternatives.")
+		}
+
+		// The name a binding pattern binds is in scope for the guard and body
+		scope := []string{}
+		if strings.HasPrefix(patterns[0], "(bind ") {
+			scope = append(scope, strings.TrimSuffix(strings.TrimPrefix(patterns[0], "(bind "), ")"))
+		}
+		p.beginScope(scope...)
+		guard := "true"
+		if !p.hadError || hadError {
+			if p.match(constants.IF) {
+				guard = p.expression()
+			}
+			p.consume(constants.ARROW, "Expect '=>' after match pattern.")
+		}
+		if p.hadError && !hadError {
+			// Rather than guess where the next arm starts, give up on the
+			// rest of the match
+			p.endScope()
+			p.skipArms()
+			return ""
+		}
+		body := p.statement()
+		p.endScope()
+
+		catchAll := patterns[0] == "_" || strings.HasPrefix(patterns[0], "(bind ")
+		if catchAll && guard == "true" {
+			exhaustive = true
+		}
+		arms = append(arms, fmt.Sprintf("(arm (%s) %s %s)", strings.Join(patterns, " "), guard, body))
+	}
+	p.consume(constants.RIGHT_BRACE, "Expect '}' after match arms.")
+	if p.hadError {
+		return ""
+	}
+
+	if p.matchWarnings && !exhaustive {
+		fmt.Fprintf(os.Stderr, "[line %d] Warning: Match has no '_' arm, so some values may match no arm.\n", keyword.Line)
+	}
+	if len(arms) == 0 {
+		return fmt.Sprintf("(match %s)", value)
+	}
+	return fmt.Sprintf("(match %s %s)", value, strings.Join(arms, " "))
+}
+
+// skipArms skips past the '}' that closes the arms of a match statement
+func (p *Parser) skipArms() {
+	depth := 1
+	for !p.isAtEnd() {
+		switch p.advance().TokenType {
+		case constants.LEFT_BRACE:
+			depth++
+		case constants.RIGHT_BRACE:
+			if depth--; depth == 0 {
+				return
+			}
+		}
+	}
+}
+
+// pattern parses one pattern of a match arm: a literal, a range of
+// literals low..high, a name to bind the value to, or _
+func (p *Parser) pattern() string {
+	if p.match(constants.IDENTIFIER) {
+		name := p.previous().Lexeme
+		if name == "_" {
+			return "_"
+		}
+		return fmt.Sprintf("(bind %s)", name)
+	}
+
+	low := p.literal()
+	if p.match(constants.DOT_DOT) {
+		return fmt.Sprintf("(range %s %s)", low, p.literal())
+	}
+	return fmt.Sprintf("(is %s)", low)
+}
+
+// literal parses a literal value in a pattern, including negative numbers
+func (p *Parser) literal() string {
+	switch {
+	case p.check(constants.MINUS) && p.checkNext(constants.NUMBER):
+		p.advance()
+		return fmt.Sprintf("(- %s)", p.advance().Literal.(string))
+	case p.check(constants.NUMBER), p.check(constants.STRING), p.check(constants.TRUE),
+		p.check(constants.FALSE), p.check(constants.NIL):
+		return p.primary()
+	}
+	p.error(p.peek(), "Expect pattern.")
+	return "_"
+}
+
+// Parse a test block: "test" STRING block
+func (p *Parser) testStatement() string {
+	keyword := p.previous()
+	if p.statementDepth > 1 {
+		p.error(keyword, "Tests must be declared at the top level.")
+	}
+	
+	name := p.consume(constants.STRING, "Expect test name.")
+	p.consume(constants.LEFT_BRACE, "Expect '{' before test body.")
+	body := p.blockStatement()
+	
+	if name.Literal == nil {
+		return ""
+	}
+	return fmt.Sprintf("(test %s %s)", sexpr.StringLiteral(name.Literal.(string)), body)
+}
+
+// Parse an assertion: "assert" expression ("," expression)? ";"
+func (p *Parser) assertStatement() string {
+	line := p.previous().Line
+	expr := p.expression()
+	
+	var message string
+	if p.match(constants.COMMA) {
+		message = p.expression()
+	}
+	p.consume(constants.SEMICOLON, "Expect ';' after assertion.")
+	
+	if message != "" {
+		return fmt.Sprintf("(assert %d %s %s)", line, expr, message)
+	}
+	return fmt.Sprintf("(assert %d %s)", line, expr)
+}
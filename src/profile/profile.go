@@ -0,0 +1,209 @@
+package profile
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"moji/src/evaluator"
+)
+
+// lineStats accumulates the cost of every statement starting on one line
+type lineStats struct {
+	line      int
+	count     int
+	total     time.Duration // Time in executeStatement, including nested statements
+	self      time.Duration // Time in executeStatement, excluding nested statements
+	expr      time.Duration // Time in evaluateExpression for expressions on this line
+	exprCount int
+}
+
+// frame is a statement that is currently executing
+type frame struct {
+	line     int
+	start    time.Time
+	children time.Duration
+}
+
+// Profiler records per-line execution counts and timings
+type Profiler struct {
+	filename  string
+	lines     []string
+	stats     map[int]*lineStats
+	stack     []frame
+	folded    map[string]time.Duration
+	exprDepth int
+	exprStart time.Time
+	exprLine  int
+	start     time.Time
+	elapsed   time.Duration
+}
+
+// NewProfiler creates a profiler for the given source file
+func NewProfiler(filename string, source string) *Profiler {
+	return &Profiler{
+		filename: filename,
+		lines:    strings.Split(source, "\n"),
+		stats:    make(map[int]*lineStats),
+		folded:   make(map[string]time.Duration),
+	}
+}
+
+// Hooks returns the evaluator callbacks that feed the profile
+func (p *Profiler) Hooks() *evaluator.Hooks {
+	return &evaluator.Hooks{
+		BeforeStatement:  p.beforeStatement,
+		AfterStatement:   p.afterStatement,
+		BeforeExpression: p.beforeExpression,
+		AfterExpression:  p.afterExpression,
+	}
+}
+
+// Start marks the beginning of the profiled run
+func (p *Profiler) Start() {
+	p.start = time.Now()
+}
+
+// Stop marks the end of the profiled run
+func (p *Profiler) Stop() {
+	p.elapsed = time.Since(p.start)
+}
+
+func (p *Profiler) beforeStatement(line int, stmt string, depth int) error {
+	// Block overhead is charged to the statement that owns the block
+	if isBlock(stmt) {
+		return nil
+	}
+	p.stack = append(p.stack, frame{line: line, start: time.Now()})
+	return nil
+}
+
+func (p *Profiler) afterStatement(line int, stmt string, depth int) {
+	if isBlock(stmt) || len(p.stack) == 0 {
+		return
+	}
+
+	top := p.stack[len(p.stack)-1]
+	p.stack = p.stack[:len(p.stack)-1]
+
+	total := time.Since(top.start)
+	self := total - top.children
+	if len(p.stack) > 0 {
+		p.stack[len(p.stack)-1].children += total
+	}
+
+	stats := p.lineStats(line)
+	stats.count++
+	stats.self += self
+	// Recursion through nested statements on the same line would count twice
+	if !p.onStack(line) {
+		stats.total += total
+	}
+
+	p.folded[p.stackKey(line)] += self
+}
+
+func (p *Profiler) beforeExpression(expr string) {
+	// Only the outermost expression is timed, nested ones are part of it
+	if p.exprDepth == 0 {
+		p.exprStart = time.Now()
+		if len(p.stack) > 0 {
+			p.exprLine = p.stack[len(p.stack)-1].line
+		}
+	}
+	p.exprDepth++
+}
+
+func (p *Profiler) afterExpression(expr string, value string, err error, line int) {
+	p.exprDepth--
+	if p.exprDepth == 0 {
+		stats := p.lineStats(p.exprLine)
+		stats.expr += time.Since(p.exprStart)
+		stats.exprCount++
+	}
+}
+
+func (p *Profiler) lineStats(line int) *lineStats {
+	stats, ok := p.stats[line]
+	if !ok {
+		stats = &lineStats{line: line}
+		p.stats[line] = stats
+	}
+	return stats
+}
+
+func (p *Profiler) onStack(line int) bool {
+	for _, f := range p.stack {
+		if f.line == line {
+			return true
+		}
+	}
+	return false
+}
+
+// stackKey names the current statement stack plus line in folded-stack form
+func (p *Profiler) stackKey(line int) string {
+	name := filepath.Base(p.filename)
+	frames := []string{name}
+	for _, f := range p.stack {
+		frames = append(frames, fmt.Sprintf("%s:%d", name, f.line))
+	}
+	frames = append(frames, fmt.Sprintf("%s:%d", name, line))
+	return strings.Join(frames, ";")
+}
+
+// WriteReport writes a table of lines sorted by the time spent on them
+func (p *Profiler) WriteReport(w io.Writer) {
+	stats := make([]*lineStats, 0, len(p.stats))
+	for _, s := range p.stats {
+		stats = append(stats, s)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].self != stats[j].self {
+			return stats[i].self > stats[j].self
+		}
+		return stats[i].line < stats[j].line
+	})
+
+	fmt.Fprintf(w, "Profile of %s (%s)\n\n", p.filename, p.elapsed)
+	fmt.Fprintf(w, "%6s %10s %12s %12s %12s  %s\n", "Line", "Count", "Total", "Self", "Expressions", "Source")
+	for _, s := range stats {
+		fmt.Fprintf(w, "%6d %10d %12s %12s %12s  %s\n",
+			s.line, s.count, s.total, s.self, s.expr, p.sourceLine(s.line))
+	}
+}
+
+// WriteFolded writes self time per statement stack in the folded-stack
+// format read by flame graph tools, with times in microseconds
+func (p *Profiler) WriteFolded(w io.Writer) error {
+	keys := make([]string, 0, len(p.folded))
+	for key := range p.folded {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		micros := p.folded[key].Microseconds()
+		if micros == 0 {
+			micros = 1
+		}
+		if _, err := fmt.Fprintf(w, "%s %d\n", key, micros); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isBlock(stmt string) bool {
+	return strings.HasPrefix(stmt, "(block")
+}
+
+func (p *Profiler) sourceLine(line int) string {
+	if line < 1 || line > len(p.lines) {
+		return ""
+	}
+	return strings.TrimSpace(p.lines[line-1])
+}
@@ -0,0 +1,237 @@
+package resolver
+
+import (
+	"strconv"
+	"strings"
+
+	"moji/src/sexpr"
+)
+
+// Resolve binds the variables declared inside blocks to a slot in their
+// block's environment, so the evaluator and the VM index a slice instead of
+// searching each environment by name. Parsed statements are rewritten to:
+//
+//	(local-var <name> <slot> <init>)                      for (var <name> <init>)
+//	(local-const <name> <slot> <init>)                    for (const <name> <init>)
+//	(local-ref <name> <line> <depth> <slot>)              for (var-ref <name> <line>)
+//	(local-assign <name> <line> <depth> <slot> <value>)   for (assign <name> <line> <value>)
+//
+// where depth counts the blocks between the reference and the declaring
+// block. The variables of a for-each loop, the name a match arm binds and
+// the value a catch clause catches get a scope of their own around the
+// body. Globals keep their by-name forms.
+//
+// A slot can still be empty when it is read, for example before its
+// declaration runs; lookups then continue by name from the declaring
+// block's enclosing environment, exactly as an unresolved lookup would.
+func Resolve(statements []string) []string {
+	r := &resolver{}
+	resolved := make([]string, 0, len(statements))
+	for _, stmt := range statements {
+		n, err := sexpr.Read(stmt)
+		if err != nil {
+			// Leave anything we cannot read for the evaluator to report
+			resolved = append(resolved, stmt)
+			continue
+		}
+		resolved = append(resolved, r.resolve(n).String())
+	}
+	return resolved
+}
+
+// Unresolve turns resolved forms in an expression back into the parser's
+// by-name forms, for tools that show expressions to the user
+func Unresolve(expr string) string {
+	if !strings.Contains(expr, "(local-") {
+		return expr
+	}
+	n, err := sexpr.Read(expr)
+	if err != nil {
+		return expr
+	}
+	return unresolve(n).String()
+}
+
+// scope maps the names declared directly in one block to their slots
+type scope map[string]int
+
+type resolver struct {
+	scopes []scope // Innermost last; globals have no scope
+}
+
+func (r *resolver) resolve(n *sexpr.Node) *sexpr.Node {
+	if !n.IsList || n.IsString {
+		return n
+	}
+
+	switch n.Head() {
+	case "block":
+		if len(n.List) == 1 {
+			return n
+		}
+		s := scope{}
+		for _, stmt := range n.List[1:] {
+			declare(s, stmt)
+		}
+		r.scopes = append(r.scopes, s)
+		for i, stmt := range n.List[1:] {
+			n.List[i+1] = r.resolve(stmt)
+		}
+		r.scopes = r.scopes[:len(r.scopes)-1]
+		return n
+	case "for-each":
+		// The loop variables live in a scope of their own, created afresh
+		// for each iteration, in slots in the order they are named
+		if len(n.List) != 4 || !n.List[1].IsList {
+			break
+		}
+		n.List[2] = r.resolve(n.List[2])
+		s := scope{}
+		for slot, name := range n.List[1].List {
+			s[name.Atom] = slot
+		}
+		declare(s, n.List[3])
+		r.scopes = append(r.scopes, s)
+		n.List[3] = r.resolve(n.List[3])
+		r.scopes = r.scopes[:len(r.scopes)-1]
+		return n
+	case "arm":
+		// A match arm that binds the value gets a scope for the name, like
+		// a for-each loop, around its guard and body
+		name, ok := boundName(n)
+		if !ok {
+			break
+		}
+		s := scope{name: 0}
+		declare(s, n.List[3])
+		r.scopes = append(r.scopes, s)
+		n.List[2] = r.resolve(n.List[2])
+		n.List[3] = r.resolve(n.List[3])
+		r.scopes = r.scopes[:len(r.scopes)-1]
+		return n
+	case "catch":
+		// The caught value lives in a scope of its own around the block
+		if len(n.List) != 3 || n.List[1].IsList {
+			break
+		}
+		r.scopes = append(r.scopes, scope{n.List[1].Atom: 0})
+		n.List[2] = r.resolve(n.List[2])
+		r.scopes = r.scopes[:len(r.scopes)-1]
+		return n
+	case "var", "const":
+		if len(n.List) != 3 || n.List[1].IsList {
+			break
+		}
+		n.List[2] = r.resolve(n.List[2])
+		if len(r.scopes) == 0 {
+			return n
+		}
+		slot := r.scopes[len(r.scopes)-1][n.List[1].Atom]
+		return list("local-"+n.Head(), n.List[1], atom(slot), n.List[2])
+	case "var-ref":
+		if len(n.List) != 3 || n.List[1].IsList {
+			break
+		}
+		if depth, slot, ok := r.lookup(n.List[1].Atom); ok {
+			return list("local-ref", n.List[1], n.List[2], atom(depth), atom(slot))
+		}
+		return n
+	case "assign":
+		if len(n.List) != 4 || n.List[1].IsList {
+			break
+		}
+		n.List[3] = r.resolve(n.List[3])
+		if depth, slot, ok := r.lookup(n.List[1].Atom); ok {
+			return list("local-assign", n.List[1], n.List[2], atom(depth), atom(slot), n.List[3])
+		}
+		return n
+	}
+
+	for i, child := range n.List {
+		n.List[i] = r.resolve(child)
+	}
+	return n
+}
+
+// lookup finds the innermost block declaring a name
+func (r *resolver) lookup(name string) (depth int, slot int, ok bool) {
+	for i := len(r.scopes) - 1; i >= 0; i-- {
+		if slot, ok := r.scopes[i][name]; ok {
+			return len(r.scopes) - 1 - i, slot, true
+		}
+	}
+	return 0, 0, false
+}
+
+// declare assigns slots to the variables a statement declares in the
+// current block, including those under if and while statements that
+// do not open a block of their own. For-each loops and catch clauses
+// always open one.
+func declare(s scope, n *sexpr.Node) {
+	if !n.IsList || n.IsString {
+		return
+	}
+	switch n.Head() {
+	case "block", "for-each", "catch":
+		return
+	case "arm":
+		if _, ok := boundName(n); ok {
+			return
+		}
+	case "var", "const":
+		if len(n.List) == 3 && !n.List[1].IsList {
+			if _, ok := s[n.List[1].Atom]; !ok {
+				s[n.List[1].Atom] = len(s)
+			}
+		}
+		return
+	}
+	for _, child := range n.List {
+		declare(s, child)
+	}
+}
+
+// boundName returns the name an (arm (<pattern>...) <guard> <body>) of a
+// match statement binds the matched value to, if it has a binding pattern
+func boundName(n *sexpr.Node) (string, bool) {
+	if len(n.List) != 4 || !n.List[1].IsList || len(n.List[1].List) != 1 {
+		return "", false
+	}
+	pattern := n.List[1].List[0]
+	if pattern.Head() != "bind" || len(pattern.List) != 2 {
+		return "", false
+	}
+	return pattern.List[1].Atom, true
+}
+
+func unresolve(n *sexpr.Node) *sexpr.Node {
+	if !n.IsList || n.IsString {
+		return n
+	}
+	for i, child := range n.List {
+		n.List[i] = unresolve(child)
+	}
+	switch n.Head() {
+	case "local-var", "local-const":
+		if len(n.List) == 4 {
+			return list(strings.TrimPrefix(n.Head(), "local-"), n.List[1], n.List[3])
+		}
+	case "local-ref":
+		if len(n.List) == 5 {
+			return list("var-ref", n.List[1], n.List[2])
+		}
+	case "local-assign":
+		if len(n.List) == 6 {
+			return list("assign", n.List[1], n.List[2], n.List[5])
+		}
+	}
+	return n
+}
+
+func atom(value int) *sexpr.Node {
+	return &sexpr.Node{Atom: strconv.Itoa(value)}
+}
+
+func list(head string, children ...*sexpr.Node) *sexpr.Node {
+	return &sexpr.Node{IsList: true, List: append([]*sexpr.Node{{Atom: head}}, children...)}
+}
@@ -4,27 +4,37 @@ import "moji/src/scanner/types"
 
 const (
 	// Single-character tokens.
-	LEFT_PAREN  types.TokenType = "LEFT_PAREN"
-	RIGHT_PAREN types.TokenType = "RIGHT_PAREN"
-	LEFT_BRACE  types.TokenType = "LEFT_BRACE"
-	RIGHT_BRACE types.TokenType = "RIGHT_BRACE"
-	COMMA       types.TokenType = "COMMA"
-	DOT         types.TokenType = "DOT"
-	MINUS       types.TokenType = "MINUS"
-	PLUS        types.TokenType = "PLUS"
-	SEMICOLON   types.TokenType = "SEMICOLON"
-	SLASH       types.TokenType = "SLASH"
-	STAR        types.TokenType = "STAR"
+	LEFT_PAREN    types.TokenType = "LEFT_PAREN"
+	RIGHT_PAREN   types.TokenType = "RIGHT_PAREN"
+	LEFT_BRACE    types.TokenType = "LEFT_BRACE"
+	RIGHT_BRACE   types.TokenType = "RIGHT_BRACE"
+	LEFT_BRACKET  types.TokenType = "LEFT_BRACKET"
+	RIGHT_BRACKET types.TokenType = "RIGHT_BRACKET"
+	COMMA         types.TokenType = "COMMA"
+	DOT           types.TokenType = "DOT"
+	MINUS         types.TokenType = "MINUS"
+	PLUS          types.TokenType = "PLUS"
+	SEMICOLON     types.TokenType = "SEMICOLON"
+	SLASH         types.TokenType = "SLASH"
+	STAR          types.TokenType = "STAR"
+	PERCENT       types.TokenType = "PERCENT"
+	QUESTION      types.TokenType = "QUESTION"
+	COLON         types.TokenType = "COLON"
 
 	// One or two character tokens.
-	BANG          types.TokenType = "BANG"
-	BANG_EQUAL    types.TokenType = "BANG_EQUAL"
-	EQUAL         types.TokenType = "EQUAL"
-	EQUAL_EQUAL   types.TokenType = "EQUAL_EQUAL"
-	GREATER       types.TokenType = "GREATER"
-	GREATER_EQUAL types.TokenType = "GREATER_EQUAL"
-	LESS          types.TokenType = "LESS"
-	LESS_EQUAL    types.TokenType = "LESS_EQUAL"
+	BANG              types.TokenType = "BANG"
+	BANG_EQUAL        types.TokenType = "BANG_EQUAL"
+	EQUAL             types.TokenType = "EQUAL"
+	EQUAL_EQUAL       types.TokenType = "EQUAL_EQUAL"
+	GREATER           types.TokenType = "GREATER"
+	GREATER_EQUAL     types.TokenType = "GREATER_EQUAL"
+	LESS              types.TokenType = "LESS"
+	LESS_EQUAL        types.TokenType = "LESS_EQUAL"
+	STAR_STAR         types.TokenType = "STAR_STAR"
+	TILDE_SLASH       types.TokenType = "TILDE_SLASH"
+	QUESTION_QUESTION types.TokenType = "QUESTION_QUESTION"
+	DOT_DOT           types.TokenType = "DOT_DOT"
+	ARROW             types.TokenType = "ARROW"
 
 	// Literals.
 	IDENTIFIER types.TokenType = "IDENTIFIER"
@@ -32,27 +42,43 @@ const (
 	NUMBER     types.TokenType = "NUMBER"
 
 	// Keywords.
-	AND    types.TokenType = "AND"
-	CLASS  types.TokenType = "CLASS"
-	ELSE   types.TokenType = "ELSE"
-	FALSE  types.TokenType = "FALSE"
-	FUN    types.TokenType = "FUN"
-	FOR    types.TokenType = "FOR"
-	IF     types.TokenType = "IF"
-	NIL    types.TokenType = "NIL"
-	OR     types.TokenType = "OR"
-	PRINT  types.TokenType = "PRINT"
-	RETURN types.TokenType = "RETURN"
-	SUPER  types.TokenType = "SUPER"
-	THIS   types.TokenType = "THIS"
-	TRUE   types.TokenType = "TRUE"
-	VAR    types.TokenType = "VAR"
-	WHILE  types.TokenType = "WHILE"
-	EOF    types.TokenType = "EOF"
+	AND      types.TokenType = "AND"
+	CLASS    types.TokenType = "CLASS"
+	ELSE     types.TokenType = "ELSE"
+	FALSE    types.TokenType = "FALSE"
+	FUN      types.TokenType = "FUN"
+	FOR      types.TokenType = "FOR"
+	IF       types.TokenType = "IF"
+	NIL      types.TokenType = "NIL"
+	OR       types.TokenType = "OR"
+	PRINT    types.TokenType = "PRINT"
+	RETURN   types.TokenType = "RETURN"
+	SUPER    types.TokenType = "SUPER"
+	THIS     types.TokenType = "THIS"
+	TRUE     types.TokenType = "TRUE"
+	VAR      types.TokenType = "VAR"
+	CONST    types.TokenType = "CONST"
+	WHILE    types.TokenType = "WHILE"
+	EACH     types.TokenType = "EACH"
+	BREAK    types.TokenType = "BREAK"
+	CONTINUE types.TokenType = "CONTINUE"
+	MATCH    types.TokenType = "MATCH"
+	THROW    types.TokenType = "THROW"
+	TRY      types.TokenType = "TRY"
+	CATCH    types.TokenType = "CATCH"
+	FINALLY  types.TokenType = "FINALLY"
+	IMPORT   types.TokenType = "IMPORT"
+	EXPORT   types.TokenType = "EXPORT"
+	TEST     types.TokenType = "TEST"
+	ASSERT   types.TokenType = "ASSERT"
+	MAP      types.TokenType = "MAP"
+	EOF      types.TokenType = "EOF"
 )
 
 var Keywords = map[string]types.TokenType{
 	"and":    AND,
+	"assert": ASSERT,
+	"🧐":     ASSERT,
 	"class":  CLASS,
 	"else":   ELSE,
 	"↩️":     ELSE,
@@ -71,5 +97,33 @@ var Keywords = map[string]types.TokenType{
 	"true":   TRUE,
 	"✅":     TRUE,
 	"🎁":     VAR,
+	"🔒":     CONST,
 	"🔄":     WHILE,
+	"🔁":     EACH,
+	"break":  BREAK,
+	"⏹️":     BREAK,
+	"continue": CONTINUE,
+	"⏭️":     CONTINUE,
+	"🎯":     MATCH,
+	"➡️":     ARROW,
+	"throw":  THROW,
+	"🚨":     THROW,
+	"try":    TRY,
+	"🛟":     TRY,
+	"catch":  CATCH,
+	"🥅":     CATCH,
+	"finally": FINALLY,
+	"🧹":     FINALLY,
+	"import": IMPORT,
+	"📥":     IMPORT,
+	"export": EXPORT,
+	"📤":     EXPORT,
+	"🧪":     TEST,
+	"♻️":     PERCENT,
+	"➗":     TILDE_SLASH,
+	"💪":     STAR_STAR,
+	"❓":     QUESTION,
+	"❗":     COLON,
+	"🤷":     QUESTION_QUESTION,
+	"🗺️":     MAP,
 } 
\ No newline at end of file
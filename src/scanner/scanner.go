@@ -68,10 +68,18 @@ func (s *scanner) scanToken() {
 		s.addToken(constants.LEFT_BRACE, nil)
 	case '}':
 		s.addToken(constants.RIGHT_BRACE, nil)
+	case '[':
+		s.addToken(constants.LEFT_BRACKET, nil)
+	case ']':
+		s.addToken(constants.RIGHT_BRACKET, nil)
 	case ',':
 		s.addToken(constants.COMMA, nil)
 	case '.':
-		s.addToken(constants.DOT, nil)
+		if s.match('.') {
+			s.addToken(constants.DOT_DOT, nil)
+		} else {
+			s.addToken(constants.DOT, nil)
+		}
 	case '-':
 		s.addToken(constants.MINUS, nil)
 	case '+':
@@ -79,10 +87,33 @@ func (s *scanner) scanToken() {
 	case ';':
 		s.addToken(constants.SEMICOLON, nil)
 	case '*':
-		s.addToken(constants.STAR, nil)
+		if s.match('*') {
+			s.addToken(constants.STAR_STAR, nil)
+		} else {
+			s.addToken(constants.STAR, nil)
+		}
+	case '%':
+		s.addToken(constants.PERCENT, nil)
+	case '?':
+		if s.match('?') {
+			s.addToken(constants.QUESTION_QUESTION, nil)
+		} else {
+			s.addToken(constants.QUESTION, nil)
+		}
+	case ':':
+		s.addToken(constants.COLON, nil)
+	case '~':
+		// Integer division is spelled ~/, a lone ~ means nothing
+		if s.match('/') {
+			s.addToken(constants.TILDE_SLASH, nil)
+		} else {
+			s.error(fmt.Sprintf("Unexpected character: %c", c))
+		}
 	case '=':
 		if s.match('=') {
 			s.addToken(constants.EQUAL_EQUAL, nil)
+		} else if s.match('>') {
+			s.addToken(constants.ARROW, nil)
 		} else {
 			s.addToken(constants.EQUAL, nil)
 		}
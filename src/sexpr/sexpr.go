@@ -0,0 +1,173 @@
+package sexpr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Node is a parsed S-expression from the parser output
+type Node struct {
+	Atom     string  // Set for atoms such as numbers, names and operators
+	List     []*Node // Set for lists; the first element is usually the operator
+	IsList   bool
+	Text     string // Content of a (string ...) literal, unescaped
+	IsString bool
+}
+
+// Head returns the operator of a list, or "" for atoms and empty lists
+func (n *Node) Head() string {
+	if !n.IsList || len(n.List) == 0 || n.List[0].IsList {
+		return ""
+	}
+	return n.List[0].Atom
+}
+
+func (n *Node) String() string {
+	if n.IsString {
+		return StringLiteral(n.Text)
+	}
+	if !n.IsList {
+		return n.Atom
+	}
+	parts := make([]string, len(n.List))
+	for i, child := range n.List {
+		parts[i] = child.String()
+	}
+	return "(" + strings.Join(parts, " ") + ")"
+}
+
+// Parentheses and backslashes inside string literals are escaped, so the
+// text of a literal never unbalances the expression around it
+var (
+	escaper   = strings.NewReplacer(`\`, `\\`, "(", `\[`, ")", `\]`)
+	unescaper = strings.NewReplacer(`\\`, `\`, `\[`, "(", `\]`, ")")
+)
+
+// StringLiteral returns the (string ...) form the parser emits for text
+func StringLiteral(text string) string {
+	return "(string " + escaper.Replace(text) + ")"
+}
+
+// UnescapeString returns the text of a string literal from the content of
+// its (string ...) form
+func UnescapeString(content string) string {
+	return unescaper.Replace(content)
+}
+
+// Display returns parser output with the text of its string literals
+// unescaped, the way the parse command shows it. The result is for people
+// to read and may not read back.
+func Display(expr string) string {
+	const prefix = "(string "
+	var b strings.Builder
+	for {
+		start := strings.Index(expr, prefix)
+		if start == -1 {
+			b.WriteString(expr)
+			return b.String()
+		}
+		b.WriteString(expr[:start+len(prefix)])
+		expr = expr[start+len(prefix):]
+
+		// The literal ends at its first unescaped closing parenthesis
+		end := 0
+		for end < len(expr) && expr[end] != ')' {
+			if expr[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		end = min(end, len(expr))
+		b.WriteString(UnescapeString(expr[:end]))
+		expr = expr[end:]
+	}
+}
+
+// Read parses one S-expression
+func Read(source string) (*Node, error) {
+	r := &reader{source: source}
+	n, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+	r.skipSpaces()
+	if r.pos != len(r.source) {
+		return nil, fmt.Errorf("unexpected text after expression: %q", r.source[r.pos:])
+	}
+	return n, nil
+}
+
+type reader struct {
+	source string
+	pos    int
+}
+
+func (r *reader) skipSpaces() {
+	for r.pos < len(r.source) && (r.source[r.pos] == ' ' || r.source[r.pos] == '\t' || r.source[r.pos] == '\n') {
+		r.pos++
+	}
+}
+
+func (r *reader) read() (*Node, error) {
+	r.skipSpaces()
+	if r.pos >= len(r.source) {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+
+	if r.source[r.pos] == ')' {
+		return nil, fmt.Errorf("unexpected ')' at offset %d", r.pos)
+	}
+
+	if r.source[r.pos] != '(' {
+		start := r.pos
+		for r.pos < len(r.source) && r.source[r.pos] != ' ' && r.source[r.pos] != '(' && r.source[r.pos] != ')' {
+			r.pos++
+		}
+		return &Node{Atom: r.source[start:r.pos]}, nil
+	}
+
+	if strings.HasPrefix(r.source[r.pos:], "(string ") {
+		end := matchingParen(r.source, r.pos)
+		if end == -1 {
+			return nil, fmt.Errorf("unterminated string literal")
+		}
+		text := r.source[r.pos+len("(string ") : end]
+		r.pos = end + 1
+		return &Node{IsString: true, Text: UnescapeString(text)}, nil
+	}
+
+	r.pos++ // Consume '('
+	n := &Node{IsList: true}
+	for {
+		r.skipSpaces()
+		if r.pos >= len(r.source) {
+			return nil, fmt.Errorf("missing ')'")
+		}
+		if r.source[r.pos] == ')' {
+			r.pos++
+			return n, nil
+		}
+		child, err := r.read()
+		if err != nil {
+			return nil, err
+		}
+		n.List = append(n.List, child)
+	}
+}
+
+// matchingParen finds the parenthesis closing the one at start
+func matchingParen(s string, start int) int {
+	depth := 0
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
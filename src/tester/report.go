@@ -0,0 +1,177 @@
+package tester
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Summary counts passing, failing and erroring tests
+func Summary(results []Result) (passed, failed, errored int) {
+	for _, r := range results {
+		switch {
+		case r.Passed:
+			passed++
+		case r.IsError:
+			errored++
+		default:
+			failed++
+		}
+	}
+	return
+}
+
+// WriteText writes a human readable report
+func WriteText(w io.Writer, results []Result) {
+	var total time.Duration
+	for _, r := range results {
+		total += r.Duration
+		status := "PASS"
+		if !r.Passed {
+			status = "FAIL"
+		}
+		fmt.Fprintf(w, "%s  %s › %s (%s)\n", status, r.File, r.Name, r.Duration)
+		if !r.Passed {
+			for _, line := range strings.Split(r.Failure, "\n") {
+				fmt.Fprintf(w, "      %s\n", line)
+			}
+			if r.Output != "" {
+				fmt.Fprintln(w, "      output:")
+				for _, line := range strings.Split(strings.TrimRight(r.Output, "\n"), "\n") {
+					fmt.Fprintf(w, "        %s\n", line)
+				}
+			}
+		}
+	}
+
+	passed, failed, errored := Summary(results)
+	fmt.Fprintf(w, "\n%d passed, %d failed, %s (%s)\n", passed, failed, count(errored, "error"), total)
+}
+
+// count formats a number of things, with word in the plural unless n is 1
+func count(n int, word string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, word)
+	}
+	return fmt.Sprintf("%d %ss", n, word)
+}
+
+// WriteTAP writes the report in the Test Anything Protocol, version 13
+func WriteTAP(w io.Writer, results []Result) {
+	fmt.Fprintln(w, "TAP version 13")
+	fmt.Fprintf(w, "1..%d\n", len(results))
+	for i, r := range results {
+		status := "ok"
+		if !r.Passed {
+			status = "not ok"
+		}
+		fmt.Fprintf(w, "%s %d - %s › %s\n", status, i+1, r.File, r.Name)
+
+		// The YAML block must follow its test line directly, so the
+		// output goes inside it
+		fmt.Fprintln(w, "  ---")
+		fmt.Fprintf(w, "  duration_ms: %.3f\n", float64(r.Duration.Microseconds())/1000)
+		if !r.Passed {
+			message := strings.SplitN(r.Failure, "\n", 2)[0]
+			fmt.Fprintf(w, "  message: %q\n", message)
+			severity := "fail"
+			if r.IsError {
+				severity = "error"
+			}
+			fmt.Fprintf(w, "  severity: %s\n", severity)
+			fmt.Fprintf(w, "  at:\n    file: %q\n    line: %d\n", r.File, r.Line)
+		}
+		if r.Output != "" {
+			fmt.Fprintln(w, "  output: |")
+			for _, line := range strings.Split(strings.TrimRight(r.Output, "\n"), "\n") {
+				fmt.Fprintf(w, "    %s\n", line)
+			}
+		}
+		fmt.Fprintln(w, "  ...")
+	}
+}
+
+type junitSuites struct {
+	XMLName xml.Name     `xml:"testsuites"`
+	Suites  []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Errors   int         `xml:"errors,attr"`
+	Time     string      `xml:"time,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitProblem `xml:"failure,omitempty"`
+	Error     *junitProblem `xml:"error,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitProblem struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the report as JUnit XML with one suite per file
+func WriteJUnit(w io.Writer, results []Result) error {
+	suites := junitSuites{}
+	index := map[string]int{}
+	durations := map[string]time.Duration{}
+
+	for _, r := range results {
+		i, ok := index[r.File]
+		if !ok {
+			i = len(suites.Suites)
+			index[r.File] = i
+			suites.Suites = append(suites.Suites, junitSuite{Name: r.File})
+		}
+		suite := &suites.Suites[i]
+		suite.Tests++
+		durations[r.File] += r.Duration
+
+		c := junitCase{
+			Name:      r.Name,
+			Classname: r.File,
+			Time:      seconds(r.Duration),
+			SystemOut: r.Output,
+		}
+		if !r.Passed {
+			problem := &junitProblem{Message: strings.SplitN(r.Failure, "\n", 2)[0], Text: r.Failure}
+			if r.IsError {
+				suite.Errors++
+				c.Error = problem
+			} else {
+				suite.Failures++
+				c.Failure = problem
+			}
+		}
+		suite.Cases = append(suite.Cases, c)
+	}
+	for i := range suites.Suites {
+		suites.Suites[i].Time = seconds(durations[suites.Suites[i].Name])
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.6f", d.Seconds())
+}
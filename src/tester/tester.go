@@ -0,0 +1,146 @@
+package tester
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"moji/src/evaluator"
+	"moji/src/parser"
+	"moji/src/scanner"
+	"moji/src/scanner/types"
+)
+
+// Result is the outcome of a single test block
+type Result struct {
+	File     string
+	Name     string
+	Line     int // Line of the failed assertion or error, or of the test block
+	Passed   bool
+	Failure  string // Assertion or error message when the test did not pass
+	IsError  bool   // The test stopped on an error rather than a failed assertion
+	Duration time.Duration
+	Output   string // Everything the test printed
+}
+
+// Discover returns every *_test.mji file under the given files or directories
+func Discover(paths []string) ([]string, error) {
+	files := []string{}
+	for _, root := range paths {
+		info, err := os.Stat(root)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, root)
+			continue
+		}
+
+		err = filepath.WalkDir(root, func(path string, entry os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !entry.IsDir() && strings.HasSuffix(entry.Name(), "_test.mji") {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+// RunFile runs every test block in a file. Each test gets a fresh global
+// environment in which the file's other top-level statements run first,
+// importing modules afresh; searchPath lists where else to find them.
+// Numbers are computed in mode, and warnMatch warns about match statements
+// that may match no arm.
+func RunFile(path string, searchPath []string, mode evaluator.NumericMode, warnMatch bool) []Result {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return []Result{fileError(path, err.Error())}
+	}
+
+	s := scanner.NewScanner(string(contents))
+	tokens := s.ScanTokens()
+	if s.HasError() {
+		return []Result{fileError(path, "Could not scan file.")}
+	}
+
+	p := parser.NewParser(tokens)
+	p.EnableLineMarkers()
+	if warnMatch {
+		p.EnableMatchWarnings()
+	}
+	statements, ok := p.TryParseStatements()
+	if !ok {
+		return []Result{fileError(path, "Could not parse file.")}
+	}
+
+	setup, tests := evaluator.FindTests(statements)
+	results := make([]Result, 0, len(tests))
+	for _, test := range tests {
+		results = append(results, runTest(path, tokens, setup, test, searchPath, mode, warnMatch))
+	}
+	return results
+}
+
+func runTest(path string, tokens []types.Token, setup []string, test evaluator.TestCase, searchPath []string, mode evaluator.NumericMode, warnMatch bool) Result {
+	var output bytes.Buffer
+	e := evaluator.NewEvaluator(parser.NewParser(tokens))
+	e.SetNumericMode(mode)
+	modules := evaluator.NewModuleLoader(searchPath)
+	if warnMatch {
+		modules.EnableMatchWarnings()
+	}
+	e.SetModules(modules, path)
+	e.SetOutput(&output)
+
+	start := time.Now()
+	err := e.ExecuteStatements(setup)
+	if err == nil {
+		err = e.RunTest(test)
+	}
+
+	result := Result{
+		File:     path,
+		Name:     test.Name,
+		Line:     test.Line,
+		Passed:   err == nil,
+		Duration: time.Since(start),
+		Output:   output.String(),
+	}
+	if err != nil {
+		_, isAssertion := err.(*evaluator.AssertionError)
+		result.IsError = !isAssertion
+		result.Failure = err.Error()
+		if line := errorLine(err); line > 0 {
+			result.Line = line
+		}
+	}
+	return result
+}
+
+// errorLine returns the line an assertion or error reports, or 0
+func errorLine(err error) int {
+	switch err := err.(type) {
+	case *evaluator.AssertionError:
+		return err.Line
+	case *evaluator.RuntimeError:
+		return err.Line
+	case *evaluator.LimitError:
+		return err.Line
+	}
+	return 0
+}
+
+func fileError(path string, message string) Result {
+	return Result{File: path, Name: filepath.Base(path), Failure: message, IsError: true}
+}
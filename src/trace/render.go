@@ -0,0 +1,101 @@
+package trace
+
+import (
+	"strings"
+
+	"moji/src/sexpr"
+)
+
+// infixOperators are the parser's binary forms, which it names after their
+// ASCII spelling in source
+var infixOperators = map[string]bool{
+	"+": true, "-": true, "*": true, "/": true, "%": true, "~/": true, "**": true,
+	"==": true, "!=": true, ">": true, ">=": true, "<": true, "<=": true,
+	"and": true, "or": true, "??": true,
+}
+
+// render writes a parsed expression back the way it could appear in
+// source, so the trace shows c < 2 rather than (< (var-ref c 2) 2.0).
+// Forms it does not know are shown as parsed.
+func render(expr string) string {
+	n, err := sexpr.Read(expr)
+	if err != nil {
+		return expr
+	}
+	return renderNode(n)
+}
+
+func renderNode(n *sexpr.Node) string {
+	if n.IsString {
+		return "\"" + n.Text + "\""
+	}
+	if !n.IsList {
+		return renderAtom(n.Atom)
+	}
+	if len(n.List) == 0 {
+		return "()"
+	}
+
+	operator := n.Head()
+	args := n.List[1:]
+	switch {
+	case operator == "var-ref" && len(args) >= 1:
+		return args[0].Atom
+	case operator == "group" && len(args) == 1:
+		return "(" + renderNode(args[0]) + ")"
+	case infixOperators[operator] && len(args) == 2:
+		return renderNode(args[0]) + " " + operator + " " + renderNode(args[1])
+	case (operator == "-" || operator == "!") && len(args) == 1:
+		return operator + renderNode(args[0])
+	case operator == "?" && len(args) == 3:
+		return renderNode(args[0]) + " ? " + renderNode(args[1]) + " : " + renderNode(args[2])
+	case operator == "call" && len(args) >= 2:
+		// (call <name> <line> <argument>...)
+		return args[0].Atom + "(" + renderList(args[2:], ", ") + ")"
+	case operator == "index" && len(args) == 2:
+		return renderNode(args[0]) + "[" + renderNode(args[1]) + "]"
+	case operator == "slice" && len(args) == 3:
+		return renderNode(args[0]) + "[" + renderBound(args[1]) + ":" + renderBound(args[2]) + "]"
+	case operator == "list":
+		return "[" + renderList(args, ", ") + "]"
+	case operator == "map" && len(args)%2 == 0:
+		entries := make([]string, 0, len(args)/2)
+		for i := 0; i < len(args); i += 2 {
+			entries = append(entries, renderNode(args[i])+": "+renderNode(args[i+1]))
+		}
+		return "🗺️{" + strings.Join(entries, ", ") + "}"
+	case operator == "range" && len(args) == 3:
+		bounds := renderNode(args[0]) + ".." + renderNode(args[1])
+		if step := renderNode(args[2]); step != "1" {
+			bounds += " step " + step
+		}
+		return bounds
+	}
+	return n.String()
+}
+
+func renderList(nodes []*sexpr.Node, separator string) string {
+	parts := make([]string, len(nodes))
+	for i, node := range nodes {
+		parts[i] = renderNode(node)
+	}
+	return strings.Join(parts, separator)
+}
+
+// renderBound leaves out a missing slice bound, which the parser stores as nil
+func renderBound(n *sexpr.Node) string {
+	if !n.IsList && n.Atom == "nil" {
+		return ""
+	}
+	return renderNode(n)
+}
+
+// renderAtom shows number literals without the trailing .0 the scanner
+// gives them
+func renderAtom(atom string) string {
+	if strings.Contains(atom, ".") && strings.Trim(atom, "-0123456789.") == "" {
+		atom = strings.TrimRight(atom, "0")
+		atom = strings.TrimSuffix(atom, ".")
+	}
+	return atom
+}
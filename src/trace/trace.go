@@ -0,0 +1,102 @@
+package trace
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"moji/src/evaluator"
+	"moji/src/resolver"
+)
+
+// Tracer writes a log of every executed statement, the values its
+// expressions produced and every variable definition or assignment
+type Tracer struct {
+	out      io.Writer
+	lines    []string
+	indent   int
+	lastLine int // Line whose source was logged last
+}
+
+// NewTracer creates a tracer for the given source that logs to out
+func NewTracer(out io.Writer, source string) *Tracer {
+	return &Tracer{
+		out:   out,
+		lines: strings.Split(source, "\n"),
+	}
+}
+
+// Hooks returns the evaluator callbacks that feed the trace
+func (t *Tracer) Hooks() *evaluator.Hooks {
+	return &evaluator.Hooks{
+		BeforeStatement: t.beforeStatement,
+		AfterStatement:  t.afterStatement,
+		AfterExpression: t.afterExpression,
+		OnDefine:        t.onDefine,
+		OnAssign:        t.onAssign,
+	}
+}
+
+func (t *Tracer) beforeStatement(line int, stmt string, depth int) error {
+	t.indent = depth - 1
+	// Blocks are traced through the statements they contain, and a line
+	// holding several statements is shown once
+	if !strings.HasPrefix(stmt, "(block") && line != t.lastLine {
+		t.log(line, 0, t.sourceLine(line))
+		t.lastLine = line
+	}
+	return nil
+}
+
+func (t *Tracer) afterStatement(line int, stmt string, depth int) {
+	t.indent = depth - 2
+}
+
+func (t *Tracer) afterExpression(expr string, value string, err error, line int) {
+	// Show variables the way they were written, not as resolved slots
+	expr = resolver.Unresolve(expr)
+
+	if err != nil {
+		t.log(line, 1, fmt.Sprintf("%s failed: %s", render(expr), strings.ReplaceAll(err.Error(), "\n", " ")))
+		return
+	}
+
+	// Literals and groups evaluate to what they already say, and
+	// assignments are logged by onAssign
+	if isLiteral(expr) || strings.HasPrefix(expr, "(group ") || strings.HasPrefix(expr, "(assign ") {
+		return
+	}
+	t.log(line, 1, fmt.Sprintf("%s => %s", render(expr), value))
+}
+
+func (t *Tracer) onDefine(name string, value string, line int) {
+	t.log(line, 1, fmt.Sprintf("define %s = %s", name, value))
+}
+
+func (t *Tracer) onAssign(name string, value string, line int) {
+	t.log(line, 1, fmt.Sprintf("assign %s = %s", name, value))
+}
+
+func (t *Tracer) log(line int, extraIndent int, message string) {
+	indent := t.indent + extraIndent
+	if indent < 0 {
+		indent = 0
+	}
+	fmt.Fprintf(t.out, "[line %d] %s%s\n", line, strings.Repeat("  ", indent), message)
+}
+
+func (t *Tracer) sourceLine(line int) string {
+	if line < 1 || line > len(t.lines) {
+		return ""
+	}
+	return strings.TrimSpace(t.lines[line-1])
+}
+
+func isLiteral(expr string) bool {
+	if expr == "true" || expr == "false" || expr == "nil" || strings.HasPrefix(expr, "(string ") {
+		return true
+	}
+	_, err := strconv.ParseFloat(expr, 64)
+	return err == nil
+}
@@ -0,0 +1,250 @@
+package vm
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Opcode is a single VM instruction
+type Opcode byte
+
+const (
+	OpConstant     Opcode = iota // [index u16] push a constant
+	OpNil                        // push nil
+	OpTrue                       // push true
+	OpFalse                      // push false
+	OpPop                        // discard the top of the stack
+	OpDefine                     // [name u16] define a variable in the current scope
+	OpGet                        // [name u16] push a variable's value
+	OpSet                        // [name u16] assign the top of the stack to a variable
+	OpDefineLocal                // [name u16] [slot u8] define a block variable in its slot
+	OpGetLocal                   // [name u16] [depth u8] [slot u8] push a block variable's value
+	OpSetLocal                   // [name u16] [depth u8] [slot u8] assign the top of the stack to a block variable
+	OpDefineConst                // [name u16] define a constant in the current scope
+	OpLocalConst                 // [name u16] [slot u8] define a block constant in its slot
+	OpAdd                        // binary +
+	OpSubtract                   // binary -
+	OpMultiply                   // binary *
+	OpDivide                     // binary /
+	OpModulo                     // binary %
+	OpFloorDivide                // binary ~/
+	OpPower                      // binary **
+	OpEqual                      // binary ==
+	OpNotEqual                   // binary !=
+	OpGreater                    // binary >
+	OpGreaterEqual               // binary >=
+	OpLess                       // binary <
+	OpLessEqual                  // binary <=
+	OpNegate                     // unary -
+	OpNot                        // unary !
+	OpList                       // [count u16] pop count elements and push a list of them
+	OpMap                        // [count u16] pop count keys and values and push a map of them
+	OpIndex                      // pop an index and a list or map, push the element
+	OpSlice                      // pop the end, start and list, push the slice
+	OpSetIndex                   // [count u8] pop a value, count indices and a list or map, push the value and the updated one
+	OpCall                       // [name u16] [count u8] pop count arguments and push the result of a built-in function
+	OpPrint                      // print and pop the top of the stack
+	OpJump                       // [offset u16] jump forward
+	OpJumpIfFalse                // [offset u16] jump forward if the top of the stack is falsey
+	OpJumpIfNotNil               // [offset u16] jump forward if the top of the stack is not nil
+	OpLoop                       // [offset u16] jump backward
+	OpPushScope                  // enter a block scope
+	OpPopScope                   // leave a block scope
+	OpIterate                    // pop a list, map or string and start iterating over it
+	OpRange                      // pop the step, end and start of a range and start iterating over it
+	OpForEach                    // [count u8] [offset u16] push count loop values, or jump forward when there are none left
+	OpEndIterate                 // stop the innermost iteration
+	OpDup                        // push a copy of the top of the stack
+	OpMatchRange                 // pop the high and low ends of a range, push whether the top of the stack lies in it
+	OpTry                        // [offset u16] handle errors by pushing the caught value and line and jumping forward
+	OpEndTry                     // remove the innermost error handler
+	OpThrow                      // pop a value and throw it
+	OpRethrow                    // pop a line and a caught value and throw it again
+	OpImport                     // [name u16] pop a module path, run the module and define its exports under name
+	OpAssert                     // [equality u8] [offset u16] check an assertion, jump forward if it holds
+	OpAssertFail                 // [message u8] raise the pending assertion failure
+)
+
+type opcodeInfo struct {
+	name     string
+	operands []int // Width in bytes of each operand
+}
+
+var opcodes = map[Opcode]opcodeInfo{
+	OpConstant:     {"CONSTANT", []int{2}},
+	OpNil:          {"NIL", nil},
+	OpTrue:         {"TRUE", nil},
+	OpFalse:        {"FALSE", nil},
+	OpPop:          {"POP", nil},
+	OpDefine:       {"DEFINE", []int{2}},
+	OpGet:          {"GET", []int{2}},
+	OpSet:          {"SET", []int{2}},
+	OpDefineLocal:  {"DEFINE_LOCAL", []int{2, 1}},
+	OpGetLocal:     {"GET_LOCAL", []int{2, 1, 1}},
+	OpSetLocal:     {"SET_LOCAL", []int{2, 1, 1}},
+	OpDefineConst:  {"DEFINE_CONST", []int{2}},
+	OpLocalConst:   {"LOCAL_CONST", []int{2, 1}},
+	OpAdd:          {"ADD", nil},
+	OpSubtract:     {"SUBTRACT", nil},
+	OpMultiply:     {"MULTIPLY", nil},
+	OpDivide:       {"DIVIDE", nil},
+	OpModulo:       {"MODULO", nil},
+	OpFloorDivide:  {"FLOOR_DIVIDE", nil},
+	OpPower:        {"POWER", nil},
+	OpEqual:        {"EQUAL", nil},
+	OpNotEqual:     {"NOT_EQUAL", nil},
+	OpGreater:      {"GREATER", nil},
+	OpGreaterEqual: {"GREATER_EQUAL", nil},
+	OpLess:         {"LESS", nil},
+	OpLessEqual:    {"LESS_EQUAL", nil},
+	OpNegate:       {"NEGATE", nil},
+	OpNot:          {"NOT", nil},
+	OpList:         {"LIST", []int{2}},
+	OpMap:          {"MAP", []int{2}},
+	OpIndex:        {"INDEX", nil},
+	OpSlice:        {"SLICE", nil},
+	OpSetIndex:     {"SET_INDEX", []int{1}},
+	OpCall:         {"CALL", []int{2, 1}},
+	OpPrint:        {"PRINT", nil},
+	OpJump:         {"JUMP", []int{2}},
+	OpJumpIfFalse:  {"JUMP_IF_FALSE", []int{2}},
+	OpJumpIfNotNil: {"JUMP_IF_NOT_NIL", []int{2}},
+	OpLoop:         {"LOOP", []int{2}},
+	OpPushScope:    {"PUSH_SCOPE", nil},
+	OpPopScope:     {"POP_SCOPE", nil},
+	OpIterate:      {"ITERATE", nil},
+	OpRange:        {"RANGE", nil},
+	OpForEach:      {"FOR_EACH", []int{1, 2}},
+	OpEndIterate:   {"END_ITERATE", nil},
+	OpDup:          {"DUP", nil},
+	OpMatchRange:   {"MATCH_RANGE", nil},
+	OpTry:          {"TRY", []int{2}},
+	OpEndTry:       {"END_TRY", nil},
+	OpThrow:        {"THROW", nil},
+	OpRethrow:      {"RETHROW", nil},
+	OpImport:       {"IMPORT", []int{2}},
+	OpAssert:       {"ASSERT", []int{1, 2}},
+	OpAssertFail:   {"ASSERT_FAIL", []int{1}},
+}
+
+// binaryOperators maps arithmetic and comparison opcodes to the operator
+// names understood by evaluator.BinaryOp
+var binaryOperators = map[Opcode]string{
+	OpAdd:          "+",
+	OpSubtract:     "-",
+	OpMultiply:     "*",
+	OpDivide:       "/",
+	OpModulo:       "%",
+	OpFloorDivide:  "~/",
+	OpPower:        "**",
+	OpEqual:        "==",
+	OpNotEqual:     "!=",
+	OpGreater:      ">",
+	OpGreaterEqual: ">=",
+	OpLess:         "<",
+	OpLessEqual:    "<=",
+}
+
+// Chunk is a compiled program: its bytecode, the source line of every byte
+// and the constant pool holding literal values and variable names
+type Chunk struct {
+	Code       []byte
+	Lines      []int
+	Constants  []string
+	Statements []int // Number of statements starting at each offset, for execution limits
+}
+
+func (c *Chunk) write(b byte, line int) {
+	c.Code = append(c.Code, b)
+	c.Lines = append(c.Lines, line)
+}
+
+// markStatement records that a statement starts at the next instruction
+func (c *Chunk) markStatement() {
+	for len(c.Statements) <= len(c.Code) {
+		c.Statements = append(c.Statements, 0)
+	}
+	c.Statements[len(c.Code)]++
+}
+
+func (c *Chunk) addConstant(value string) int {
+	for i, existing := range c.Constants {
+		if existing == value {
+			return i
+		}
+	}
+	c.Constants = append(c.Constants, value)
+	return len(c.Constants) - 1
+}
+
+func (c *Chunk) readUint16(offset int) int {
+	return int(c.Code[offset])<<8 | int(c.Code[offset+1])
+}
+
+// Disassemble writes a human readable listing of the chunk
+func (c *Chunk) Disassemble(w io.Writer, name string) {
+	fmt.Fprintf(w, "== %s ==\n", name)
+	for offset := 0; offset < len(c.Code); {
+		offset = c.disassembleInstruction(w, offset)
+	}
+
+	fmt.Fprintln(w, "\n== constants ==")
+	for i, constant := range c.Constants {
+		fmt.Fprintf(w, "%4d  %s\n", i, constant)
+	}
+}
+
+func (c *Chunk) disassembleInstruction(w io.Writer, offset int) int {
+	fmt.Fprintf(w, "%04d ", offset)
+	if offset > 0 && c.Lines[offset] == c.Lines[offset-1] {
+		fmt.Fprint(w, "   | ")
+	} else {
+		fmt.Fprintf(w, "%4d ", c.Lines[offset])
+	}
+
+	op := Opcode(c.Code[offset])
+	info, ok := opcodes[op]
+	if !ok {
+		fmt.Fprintf(w, "UNKNOWN %d\n", op)
+		return offset + 1
+	}
+
+	fmt.Fprint(w, info.name)
+	next := offset + 1
+	for i, width := range info.operands {
+		var operand int
+		if width == 2 {
+			operand = c.readUint16(next)
+		} else {
+			operand = int(c.Code[next])
+		}
+		next += width
+
+		if i == 0 {
+			fmt.Fprint(w, strings.Repeat(" ", max(1, 16-len(info.name))))
+		} else {
+			fmt.Fprint(w, " ")
+		}
+		switch op {
+		case OpConstant, OpDefine, OpGet, OpSet, OpDefineLocal, OpGetLocal, OpSetLocal, OpDefineConst, OpLocalConst, OpCall, OpImport:
+			if width == 2 {
+				fmt.Fprintf(w, "%4d '%s'", operand, c.Constants[operand])
+			} else {
+				fmt.Fprintf(w, "%d", operand)
+			}
+		case OpJump, OpJumpIfFalse, OpJumpIfNotNil, OpAssert, OpForEach, OpTry:
+			if width == 2 {
+				fmt.Fprintf(w, "-> %04d", next+operand)
+			} else {
+				fmt.Fprintf(w, "%d", operand)
+			}
+		case OpLoop:
+			fmt.Fprintf(w, "-> %04d", next-operand)
+		default:
+			fmt.Fprintf(w, "%d", operand)
+		}
+	}
+	fmt.Fprintln(w)
+	return next
+}
@@ -0,0 +1,1025 @@
+package vm
+
+import (
+	"fmt"
+	"strconv"
+
+	"moji/src/evaluator"
+	"moji/src/resolver"
+	"moji/src/sexpr"
+)
+
+// CompileError reports parser output the compiler does not understand
+type CompileError struct {
+	Message string
+	Expr    string
+}
+
+func (e *CompileError) Error() string {
+	return fmt.Sprintf("compile error: %s (expression: %s)", e.Message, e.Expr)
+}
+
+var binaryOpcodes = map[string]Opcode{
+	"+":  OpAdd,
+	"-":  OpSubtract,
+	"*":  OpMultiply,
+	"/":  OpDivide,
+	"%":  OpModulo,
+	"~/": OpFloorDivide,
+	"**": OpPower,
+	"==": OpEqual,
+	"!=": OpNotEqual,
+	">":  OpGreater,
+	">=": OpGreaterEqual,
+	"<":  OpLess,
+	"<=": OpLessEqual,
+}
+
+// Compiler turns parsed statements into a bytecode chunk
+type Compiler struct {
+	chunk   *Chunk
+	numeric evaluator.NumericMode
+	line    int
+	err     error // Set when the program exceeds the limits of the bytecode format
+	depth   int   // Number of scopes open at the current instruction
+	loops   []*loop
+	exits   []*exit // Code a break or continue must run when it jumps out, innermost last
+}
+
+// loop collects the jumps of break and continue statements in a loop body
+// until the code they jump to has been compiled
+type loop struct {
+	depth     int   // Scopes open in the loop body, outside any block in it
+	exits     int   // Exits open around the loop
+	breaks    []int // Jumps to the code that leaves the loop
+	continues []int // Jumps to the code that starts the next iteration
+}
+
+// exit is what a break or continue has to undo when it jumps out of a try
+// statement: its error handlers, its finally block and any values it left
+// on the stack
+type exit struct {
+	depth    int         // Scopes open outside the try statement
+	handlers int         // Error handlers to remove
+	values   int         // Values to pop
+	finally  *sexpr.Node // Block to run on the way out, or nil
+}
+
+// Compile compiles parsed, optionally line-marked, statements. Variables
+// declared in blocks are resolved to slots first. Number constants are
+// normalized in mode, which the chunk must also run in.
+func Compile(statements []string, mode evaluator.NumericMode) (*Chunk, error) {
+	c := &Compiler{chunk: &Chunk{}, numeric: mode, line: 1}
+	for _, stmt := range resolver.Resolve(statements) {
+		n, err := sexpr.Read(stmt)
+		if err != nil {
+			return nil, &CompileError{Message: err.Error(), Expr: stmt}
+		}
+		if err := c.statement(n); err != nil {
+			return nil, err
+		}
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.chunk, nil
+}
+
+func (c *Compiler) statement(n *sexpr.Node) error {
+	switch n.Head() {
+	case "at":
+		if len(n.List) != 3 {
+			return c.invalid(n)
+		}
+		line, err := strconv.Atoi(n.List[1].Atom)
+		if err != nil {
+			return c.invalid(n)
+		}
+		previous := c.line
+		c.line = line
+		err = c.statement(n.List[2])
+		c.line = previous
+		return err
+	}
+
+	c.chunk.markStatement()
+	switch n.Head() {
+	case "print":
+		if len(n.List) != 2 {
+			return c.invalid(n)
+		}
+		if err := c.expression(n.List[1]); err != nil {
+			return err
+		}
+		c.emit(OpPrint)
+		return nil
+	case "var", "const":
+		if len(n.List) != 3 || n.List[1].IsList {
+			return c.invalid(n)
+		}
+		if err := c.expression(n.List[2]); err != nil {
+			return err
+		}
+		op := OpDefine
+		if n.Head() == "const" {
+			op = OpDefineConst
+		}
+		c.emitWithOperand(op, c.chunk.addConstant(n.List[1].Atom))
+		return nil
+	case "local-var", "local-const":
+		if len(n.List) != 4 || n.List[1].IsList {
+			return c.invalid(n)
+		}
+		slot, err := strconv.Atoi(n.List[2].Atom)
+		if err != nil {
+			return c.invalid(n)
+		}
+		if err := c.expression(n.List[3]); err != nil {
+			return err
+		}
+		op := OpDefineLocal
+		if n.Head() == "local-const" {
+			op = OpLocalConst
+		}
+		c.emitWithOperand(op, c.chunk.addConstant(n.List[1].Atom))
+		c.emitSlot(slot, n)
+		return nil
+	case "block":
+		return c.block(n)
+	case "if":
+		return c.ifStatement(n)
+	case "while":
+		return c.whileStatement(n)
+	case "for-each":
+		return c.forEachStatement(n)
+	case "match":
+		return c.matchStatement(n)
+	case "break", "continue":
+		return c.loopControl(n)
+	case "try":
+		return c.tryStatement(n)
+	case "import":
+		if len(n.List) != 3 || n.List[2].IsList {
+			return c.invalid(n)
+		}
+		if err := c.expression(n.List[1]); err != nil {
+			return err
+		}
+		c.emitWithOperand(OpImport, c.chunk.addConstant(n.List[2].Atom))
+		return nil
+	case "export":
+		// Only modules, which always run on the evaluator, export names
+		if len(n.List) != 2 {
+			return c.invalid(n)
+		}
+		return c.statement(n.List[1])
+	case "throw":
+		if len(n.List) != 2 {
+			return c.invalid(n)
+		}
+		if err := c.expression(n.List[1]); err != nil {
+			return err
+		}
+		c.emit(OpThrow)
+		return nil
+	case "test":
+		// Tests only run under the test runner
+		return nil
+	case "assert":
+		return c.assertStatement(n)
+	}
+
+	if err := c.expression(n); err != nil {
+		return err
+	}
+	c.emit(OpPop)
+	return nil
+}
+
+func (c *Compiler) block(n *sexpr.Node) error {
+	// An empty block does not even create a scope in the evaluator
+	if len(n.List) == 1 {
+		return nil
+	}
+
+	c.emit(OpPushScope)
+	c.depth++
+	for _, stmt := range n.List[1:] {
+		if err := c.statement(stmt); err != nil {
+			return err
+		}
+	}
+	c.depth--
+	c.emit(OpPopScope)
+	return nil
+}
+
+func (c *Compiler) ifStatement(n *sexpr.Node) error {
+	if len(n.List) != 3 && len(n.List) != 4 {
+		return c.invalid(n)
+	}
+
+	if err := c.expression(n.List[1]); err != nil {
+		return err
+	}
+	elseJump := c.emitJump(OpJumpIfFalse)
+	c.emit(OpPop)
+	if err := c.statement(n.List[2]); err != nil {
+		return err
+	}
+	endJump := c.emitJump(OpJump)
+
+	c.patchJump(elseJump)
+	c.emit(OpPop)
+	if len(n.List) == 4 {
+		if err := c.statement(n.List[3]); err != nil {
+			return err
+		}
+	}
+	c.patchJump(endJump)
+	return nil
+}
+
+// whileStatement compiles (while <condition> <body> <increment>?), where
+// the increment of a desugared for loop runs after the body even when the
+// body continues early
+func (c *Compiler) whileStatement(n *sexpr.Node) error {
+	if len(n.List) != 3 && len(n.List) != 4 {
+		return c.invalid(n)
+	}
+
+	loopStart := len(c.chunk.Code)
+	if err := c.expression(n.List[1]); err != nil {
+		return err
+	}
+	exitJump := c.emitJump(OpJumpIfFalse)
+	c.emit(OpPop)
+	l, err := c.loopBody(n.List[2])
+	if err != nil {
+		return err
+	}
+	c.patchJumps(l.continues)
+	if len(n.List) == 4 {
+		if err := c.expression(n.List[3]); err != nil {
+			return err
+		}
+		c.emit(OpPop)
+	}
+	c.emitLoop(loopStart)
+
+	c.patchJump(exitJump)
+	c.emit(OpPop)
+	c.patchJumps(l.breaks)
+	return nil
+}
+
+// loopBody compiles the body of a loop, collecting the jumps of the break
+// and continue statements in it
+func (c *Compiler) loopBody(body *sexpr.Node) (*loop, error) {
+	l := &loop{depth: c.depth, exits: len(c.exits)}
+	c.loops = append(c.loops, l)
+	err := c.statement(body)
+	c.loops = c.loops[:len(c.loops)-1]
+	return l, err
+}
+
+// loopControl compiles (break) and (continue): it leaves the blocks and
+// try statements opened inside the innermost loop's body, running their
+// finally blocks, and jumps to the loop's exit or to its next iteration
+func (c *Compiler) loopControl(n *sexpr.Node) error {
+	if len(n.List) != 1 || len(c.loops) == 0 {
+		return c.invalid(n)
+	}
+	l := c.loops[len(c.loops)-1]
+	depth := c.depth
+	for i := len(c.exits) - 1; i >= l.exits; i-- {
+		e := c.exits[i]
+		for ; depth > e.depth; depth-- {
+			c.emit(OpPopScope)
+		}
+		for j := 0; j < e.values; j++ {
+			c.emit(OpPop)
+		}
+		for j := 0; j < e.handlers; j++ {
+			c.emit(OpEndTry)
+		}
+		if e.finally != nil {
+			// The finally block runs outside the try statement, where a
+			// break or continue in it only leaves the exits around it
+			exits, scopes := c.exits, c.depth
+			c.exits, c.depth = c.exits[:i], e.depth
+			err := c.statement(e.finally)
+			c.exits, c.depth = exits, scopes
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for ; depth > l.depth; depth-- {
+		c.emit(OpPopScope)
+	}
+	if n.Head() == "break" {
+		l.breaks = append(l.breaks, c.emitJump(OpJump))
+	} else {
+		l.continues = append(l.continues, c.emitJump(OpJump))
+	}
+	return nil
+}
+
+// forEachStatement compiles (for-each (<name>...) <iterable> <body>). The
+// iterator lives on the VM's iterator stack while the loop runs, until
+// END_ITERATE, and each iteration defines the loop variables in a new
+// scope around the body.
+func (c *Compiler) forEachStatement(n *sexpr.Node) error {
+	if len(n.List) != 4 || !n.List[1].IsList || len(n.List[1].List) == 0 || len(n.List[1].List) > 2 {
+		return c.invalid(n)
+	}
+	names := n.List[1].List
+
+	iterable := n.List[2]
+	if iterable.Head() == "range" {
+		if len(iterable.List) != 4 {
+			return c.invalid(n)
+		}
+		for _, bound := range iterable.List[1:] {
+			if err := c.expression(bound); err != nil {
+				return err
+			}
+		}
+		c.emit(OpRange)
+	} else {
+		if err := c.expression(iterable); err != nil {
+			return err
+		}
+		c.emit(OpIterate)
+	}
+
+	loopStart := len(c.chunk.Code)
+	c.emit(OpForEach)
+	c.emitByte(byte(len(names)))
+	exitJump := c.emitOperandPlaceholder()
+	c.emit(OpPushScope)
+	c.depth++
+	// The values are pushed in order, so the last variable is defined first
+	for slot := len(names) - 1; slot >= 0; slot-- {
+		c.emitWithOperand(OpDefineLocal, c.chunk.addConstant(names[slot].Atom))
+		c.emitSlot(slot, n)
+	}
+	l, err := c.loopBody(n.List[3])
+	if err != nil {
+		return err
+	}
+	c.patchJumps(l.continues)
+	c.emit(OpPopScope)
+	c.emitLoop(loopStart)
+
+	// Breaking out leaves the iteration's scope on the way
+	c.patchJumps(l.breaks)
+	if len(l.breaks) > 0 {
+		c.emit(OpPopScope)
+	}
+	c.depth--
+	c.patchJump(exitJump)
+	c.emit(OpEndIterate)
+	return nil
+}
+
+// matchStatement compiles (match <value> (arm (<pattern>...) <guard>
+// <body>)...). The value stays on the stack while the arms test it and is
+// popped before the body of the arm that matches runs.
+func (c *Compiler) matchStatement(n *sexpr.Node) error {
+	if len(n.List) < 2 {
+		return c.invalid(n)
+	}
+	if err := c.expression(n.List[1]); err != nil {
+		return err
+	}
+
+	var ends []int
+	for _, arm := range n.List[2:] {
+		if arm.Head() != "arm" || len(arm.List) != 4 || !arm.List[1].IsList || len(arm.List[1].List) == 0 {
+			return c.invalid(arm)
+		}
+		patterns, guard, body := arm.List[1].List, arm.List[2], arm.List[3]
+
+		// Each pattern that matches jumps to the guard; falling through
+		// all of them skips to the next arm
+		var matched []int
+		always := false
+		var binding *sexpr.Node
+		for _, pattern := range patterns {
+			if pattern.Atom == "_" && !pattern.IsList {
+				always = true
+				break
+			}
+			if pattern.Head() == "bind" && len(pattern.List) == 2 {
+				binding = pattern.List[1]
+				always = true
+				break
+			}
+			if err := c.pattern(pattern); err != nil {
+				return err
+			}
+			nextPattern := c.emitJump(OpJumpIfFalse)
+			c.emit(OpPop)
+			matched = append(matched, c.emitJump(OpJump))
+			c.patchJump(nextPattern)
+			c.emit(OpPop)
+		}
+		nextArm := -1
+		if !always {
+			nextArm = c.emitJump(OpJump)
+		}
+		c.patchJumps(matched)
+
+		if binding != nil {
+			c.emit(OpPushScope)
+			c.depth++
+			c.emit(OpDup)
+			c.emitWithOperand(OpDefineLocal, c.chunk.addConstant(binding.Atom))
+			c.emitSlot(0, arm)
+		}
+		guardFail := -1
+		if guard.IsList || guard.Atom != "true" {
+			if err := c.expression(guard); err != nil {
+				return err
+			}
+			guardFail = c.emitJump(OpJumpIfFalse)
+			c.emit(OpPop)
+		}
+		c.emit(OpPop)
+		if err := c.statement(body); err != nil {
+			return err
+		}
+		if binding != nil {
+			c.emit(OpPopScope)
+		}
+		ends = append(ends, c.emitJump(OpJump))
+
+		if guardFail >= 0 {
+			c.patchJump(guardFail)
+			c.emit(OpPop)
+			if binding != nil {
+				c.emit(OpPopScope)
+			}
+		}
+		if binding != nil {
+			c.depth--
+		}
+		if nextArm >= 0 {
+			c.patchJump(nextArm)
+		}
+	}
+
+	// No arm matched
+	c.emit(OpPop)
+	c.patchJumps(ends)
+	return nil
+}
+
+// pattern compiles a test of the match value on top of the stack against
+// an (is <literal>) or (range <low> <high>) pattern, leaving the value and
+// pushing whether it matches
+func (c *Compiler) pattern(n *sexpr.Node) error {
+	switch {
+	case n.Head() == "is" && len(n.List) == 2:
+		c.emit(OpDup)
+		if err := c.expression(n.List[1]); err != nil {
+			return err
+		}
+		c.emit(OpEqual)
+	case n.Head() == "range" && len(n.List) == 3:
+		for _, bound := range n.List[1:] {
+			if err := c.expression(bound); err != nil {
+				return err
+			}
+		}
+		c.emit(OpMatchRange)
+	default:
+		return c.invalid(n)
+	}
+	return nil
+}
+
+// tryStatement compiles (try <block> (catch <name> <block>)? (finally
+// <block>)?). The catch clause's handler is installed inside the finally
+// block's, which catches errors from the try block or the catch clause,
+// runs the finally block and throws them again. Without errors the finally
+// block runs after the others.
+func (c *Compiler) tryStatement(n *sexpr.Node) error {
+	if len(n.List) < 3 || len(n.List) > 4 {
+		return c.invalid(n)
+	}
+	var catch, finally *sexpr.Node
+	for _, clause := range n.List[2:] {
+		switch {
+		case clause.Head() == "catch" && len(clause.List) == 3 && !clause.List[1].IsList:
+			catch = clause
+		case clause.Head() == "finally" && len(clause.List) == 2:
+			finally = clause.List[1]
+		default:
+			return c.invalid(n)
+		}
+	}
+
+	finallyHandler, catchHandler := -1, -1
+	handlers := 0
+	if finally != nil {
+		finallyHandler = c.emitJump(OpTry)
+		handlers++
+	}
+	if catch != nil {
+		catchHandler = c.emitJump(OpTry)
+		handlers++
+	}
+	if err := c.guarded(&exit{depth: c.depth, handlers: handlers, finally: finally}, n.List[1]); err != nil {
+		return err
+	}
+
+	if catch != nil {
+		c.emit(OpEndTry)
+		skip := c.emitJump(OpJump)
+		c.patchJump(catchHandler)
+		// The handler pushed the caught value and its line
+		c.emit(OpPop)
+		c.emit(OpPushScope)
+		c.depth++
+		c.emitWithOperand(OpDefineLocal, c.chunk.addConstant(catch.List[1].Atom))
+		c.emitSlot(0, catch)
+		if err := c.guarded(&exit{depth: c.depth - 1, handlers: handlers - 1, finally: finally}, catch.List[2]); err != nil {
+			return err
+		}
+		c.depth--
+		c.emit(OpPopScope)
+		c.patchJump(skip)
+	}
+
+	if finally != nil {
+		c.emit(OpEndTry)
+		if err := c.statement(finally); err != nil {
+			return err
+		}
+		end := c.emitJump(OpJump)
+		c.patchJump(finallyHandler)
+		// The caught value and its line stay on the stack for RETHROW
+		if err := c.guarded(&exit{depth: c.depth, values: 2}, finally); err != nil {
+			return err
+		}
+		c.emit(OpRethrow)
+		c.patchJump(end)
+	}
+	return nil
+}
+
+// guarded compiles a statement inside an exit that a break or continue in
+// it must go through
+func (c *Compiler) guarded(e *exit, stmt *sexpr.Node) error {
+	c.exits = append(c.exits, e)
+	err := c.statement(stmt)
+	c.exits = c.exits[:len(c.exits)-1]
+	return err
+}
+
+// assertStatement compiles (assert <line> <expr> <message>?). The message is
+// only evaluated when the assertion fails, like in the evaluator.
+func (c *Compiler) assertStatement(n *sexpr.Node) error {
+	if len(n.List) != 3 && len(n.List) != 4 {
+		return c.invalid(n)
+	}
+	line, err := strconv.Atoi(n.List[1].Atom)
+	if err != nil {
+		return c.invalid(n)
+	}
+
+	previous := c.line
+	c.line = line
+	defer func() { c.line = previous }()
+
+	expr := n.List[2]
+	equality := 0
+	if expr.Head() == "==" && len(expr.List) == 3 {
+		// Equality assertions report both sides
+		equality = 1
+		if err := c.expression(expr.List[1]); err != nil {
+			return err
+		}
+		if err := c.expression(expr.List[2]); err != nil {
+			return err
+		}
+	} else if err := c.expression(expr); err != nil {
+		return err
+	}
+
+	c.emit(OpAssert)
+	c.emitByte(byte(equality))
+	passJump := c.emitOperandPlaceholder()
+
+	hasMessage := 0
+	if len(n.List) == 4 {
+		hasMessage = 1
+		if err := c.expression(n.List[3]); err != nil {
+			return err
+		}
+	}
+	c.emit(OpAssertFail)
+	c.emitByte(byte(hasMessage))
+	c.patchJump(passJump)
+	return nil
+}
+
+func (c *Compiler) expression(n *sexpr.Node) error {
+	if n.IsString {
+		c.emitConstant("\"" + n.Text + "\"")
+		return nil
+	}
+
+	if !n.IsList {
+		switch n.Atom {
+		case "true":
+			c.emit(OpTrue)
+		case "false":
+			c.emit(OpFalse)
+		case "nil":
+			c.emit(OpNil)
+		default:
+			if num, ok := evaluator.NormalizeNumber(c.numeric, n.Atom); ok {
+				c.emitConstant(num)
+			} else {
+				// The evaluator treats any other bare word as its own value
+				c.emitConstant(n.Atom)
+			}
+		}
+		return nil
+	}
+
+	// Empty parentheses evaluate to themselves
+	if len(n.List) == 0 {
+		c.emitConstant("()")
+		return nil
+	}
+
+	operator := n.Head()
+	switch operator {
+	case "var-ref":
+		return c.variable(n, OpGet, nil)
+	case "assign":
+		if len(n.List) != 4 {
+			return c.invalid(n)
+		}
+		return c.variable(n, OpSet, n.List[3])
+	case "local-ref":
+		if len(n.List) != 5 {
+			return c.invalid(n)
+		}
+		return c.localVariable(n, OpGetLocal, nil)
+	case "local-assign":
+		if len(n.List) != 6 {
+			return c.invalid(n)
+		}
+		return c.localVariable(n, OpSetLocal, n.List[5])
+	case "group":
+		if len(n.List) != 2 {
+			return c.invalid(n)
+		}
+		return c.expression(n.List[1])
+	case "and", "or":
+		return c.logical(n)
+	case "list", "map":
+		return c.collection(n)
+	case "index", "slice":
+		if (operator == "index" && len(n.List) != 3) || (operator == "slice" && len(n.List) != 4) {
+			return c.invalid(n)
+		}
+		for _, operand := range n.List[1:] {
+			if err := c.expression(operand); err != nil {
+				return err
+			}
+		}
+		if operator == "index" {
+			c.emit(OpIndex)
+		} else {
+			c.emit(OpSlice)
+		}
+		return nil
+	case "set-index":
+		return c.setIndex(n)
+	case "call":
+		return c.call(n)
+	case "?":
+		return c.conditional(n)
+	case "??":
+		return c.coalesce(n)
+	case "!":
+		if len(n.List) != 2 {
+			return c.invalid(n)
+		}
+		if err := c.expression(n.List[1]); err != nil {
+			return err
+		}
+		c.emit(OpNot)
+		return nil
+	case "-":
+		if len(n.List) == 2 {
+			if err := c.expression(n.List[1]); err != nil {
+				return err
+			}
+			c.emit(OpNegate)
+			return nil
+		}
+	case "+":
+		// Adding empty parentheses yields empty parentheses without
+		// evaluating the other operand
+		for _, operand := range n.List[1:] {
+			if operand.IsList && len(operand.List) == 0 {
+				c.emitConstant("()")
+				return nil
+			}
+		}
+	}
+
+	op, ok := binaryOpcodes[operator]
+	if !ok || len(n.List) != 3 {
+		return c.invalid(n)
+	}
+	if err := c.expression(n.List[1]); err != nil {
+		return err
+	}
+	if err := c.expression(n.List[2]); err != nil {
+		return err
+	}
+	c.emit(op)
+	return nil
+}
+
+// variable compiles (var-ref <name> <line>) or (assign <name> <line> <value>),
+// recording the reference's line for error messages
+func (c *Compiler) variable(n *sexpr.Node, op Opcode, value *sexpr.Node) error {
+	if len(n.List) < 3 || n.List[1].IsList {
+		return c.invalid(n)
+	}
+	line, err := strconv.Atoi(n.List[2].Atom)
+	if err != nil {
+		return c.invalid(n)
+	}
+
+	if value != nil {
+		if err := c.expression(value); err != nil {
+			return err
+		}
+	}
+
+	previous := c.line
+	c.line = line
+	c.emitWithOperand(op, c.chunk.addConstant(n.List[1].Atom))
+	c.line = previous
+	return nil
+}
+
+// localVariable compiles (local-ref <name> <line> <depth> <slot>) or
+// (local-assign <name> <line> <depth> <slot> <value>). The name is kept for
+// looking up outer variables while the slot is still empty.
+func (c *Compiler) localVariable(n *sexpr.Node, op Opcode, value *sexpr.Node) error {
+	depth, err1 := strconv.Atoi(n.List[3].Atom)
+	slot, err2 := strconv.Atoi(n.List[4].Atom)
+	if err1 != nil || err2 != nil {
+		return c.invalid(n)
+	}
+	if err := c.variable(n, op, value); err != nil {
+		return err
+	}
+
+	previous := c.line
+	c.line, _ = strconv.Atoi(n.List[2].Atom)
+	c.emitSlot(depth, n)
+	c.emitSlot(slot, n)
+	c.line = previous
+	return nil
+}
+
+// logical compiles short-circuiting and/or, which leave the deciding operand
+func (c *Compiler) logical(n *sexpr.Node) error {
+	if len(n.List) != 3 {
+		return c.invalid(n)
+	}
+
+	if err := c.expression(n.List[1]); err != nil {
+		return err
+	}
+	if n.Head() == "and" {
+		endJump := c.emitJump(OpJumpIfFalse)
+		c.emit(OpPop)
+		if err := c.expression(n.List[2]); err != nil {
+			return err
+		}
+		c.patchJump(endJump)
+		return nil
+	}
+
+	elseJump := c.emitJump(OpJumpIfFalse)
+	endJump := c.emitJump(OpJump)
+	c.patchJump(elseJump)
+	c.emit(OpPop)
+	if err := c.expression(n.List[2]); err != nil {
+		return err
+	}
+	c.patchJump(endJump)
+	return nil
+}
+
+// conditional compiles (? <cond> <then> <else>), evaluating only the chosen
+// branch
+func (c *Compiler) conditional(n *sexpr.Node) error {
+	if len(n.List) != 4 {
+		return c.invalid(n)
+	}
+
+	if err := c.expression(n.List[1]); err != nil {
+		return err
+	}
+	elseJump := c.emitJump(OpJumpIfFalse)
+	c.emit(OpPop)
+	if err := c.expression(n.List[2]); err != nil {
+		return err
+	}
+	endJump := c.emitJump(OpJump)
+	c.patchJump(elseJump)
+	c.emit(OpPop)
+	if err := c.expression(n.List[3]); err != nil {
+		return err
+	}
+	c.patchJump(endJump)
+	return nil
+}
+
+// coalesce compiles (?? <value> <default>), which leaves the value unless
+// it is nil
+func (c *Compiler) coalesce(n *sexpr.Node) error {
+	if len(n.List) != 3 {
+		return c.invalid(n)
+	}
+
+	if err := c.expression(n.List[1]); err != nil {
+		return err
+	}
+	endJump := c.emitJump(OpJumpIfNotNil)
+	c.emit(OpPop)
+	if err := c.expression(n.List[2]); err != nil {
+		return err
+	}
+	c.patchJump(endJump)
+	return nil
+}
+
+// collection compiles (list <element>...) and (map <key> <value>...),
+// whose operand counts elements or entries
+func (c *Compiler) collection(n *sexpr.Node) error {
+	op, count := OpList, len(n.List)-1
+	if n.Head() == "map" {
+		if count%2 != 0 {
+			return c.invalid(n)
+		}
+		op, count = OpMap, count/2
+	}
+	if count > 0xffff {
+		return &CompileError{Message: "too many elements in one " + n.Head(), Expr: n.String()}
+	}
+
+	for _, element := range n.List[1:] {
+		if err := c.expression(element); err != nil {
+			return err
+		}
+	}
+	c.emit(op)
+	c.emitByte(byte(count >> 8))
+	c.emitByte(byte(count))
+	return nil
+}
+
+// setIndex compiles (set-index <variable> <index>... <value>): it reads the
+// list, replaces the element and stores the updated list back in the
+// variable, leaving the assigned value
+func (c *Compiler) setIndex(n *sexpr.Node) error {
+	if len(n.List) < 4 || len(n.List)-3 > 0xff {
+		return c.invalid(n)
+	}
+
+	variable := n.List[1]
+	for _, operand := range n.List[1:] {
+		if err := c.expression(operand); err != nil {
+			return err
+		}
+	}
+	c.emit(OpSetIndex)
+	c.emitByte(byte(len(n.List) - 3))
+
+	var err error
+	switch variable.Head() {
+	case "var-ref":
+		err = c.variable(variable, OpSet, nil)
+	case "local-ref":
+		err = c.localVariable(variable, OpSetLocal, nil)
+	default:
+		return c.invalid(n)
+	}
+	if err != nil {
+		return err
+	}
+	c.emit(OpPop)
+	return nil
+}
+
+// call compiles (call <name> <line> <argument>...)
+func (c *Compiler) call(n *sexpr.Node) error {
+	if len(n.List) < 3 || n.List[1].IsList || len(n.List)-3 > 0xff {
+		return c.invalid(n)
+	}
+	line, err := strconv.Atoi(n.List[2].Atom)
+	if err != nil {
+		return c.invalid(n)
+	}
+
+	for _, argument := range n.List[3:] {
+		if err := c.expression(argument); err != nil {
+			return err
+		}
+	}
+
+	previous := c.line
+	c.line = line
+	c.emitWithOperand(OpCall, c.chunk.addConstant(n.List[1].Atom))
+	c.emitByte(byte(len(n.List) - 3))
+	c.line = previous
+	return nil
+}
+
+func (c *Compiler) invalid(n *sexpr.Node) error {
+	return &CompileError{Message: "invalid expression format", Expr: n.String()}
+}
+
+func (c *Compiler) emit(op Opcode) {
+	c.chunk.write(byte(op), c.line)
+}
+
+func (c *Compiler) emitByte(b byte) {
+	c.chunk.write(b, c.line)
+}
+
+func (c *Compiler) emitWithOperand(op Opcode, operand int) {
+	if operand > 0xffff {
+		c.err = &CompileError{Message: "too many constants", Expr: c.chunk.Constants[operand]}
+	}
+	c.emit(op)
+	c.emitByte(byte(operand >> 8))
+	c.emitByte(byte(operand))
+}
+
+// emitSlot emits a one-byte depth or slot operand
+func (c *Compiler) emitSlot(value int, n *sexpr.Node) {
+	if value > 0xff {
+		c.err = &CompileError{Message: "too many variables in one block", Expr: n.String()}
+	}
+	c.emitByte(byte(value))
+}
+
+func (c *Compiler) emitConstant(value string) {
+	c.emitWithOperand(OpConstant, c.chunk.addConstant(value))
+}
+
+// emitJump emits a forward jump and returns the offset of its operand
+func (c *Compiler) emitJump(op Opcode) int {
+	c.emit(op)
+	return c.emitOperandPlaceholder()
+}
+
+func (c *Compiler) emitOperandPlaceholder() int {
+	c.emitByte(0xff)
+	c.emitByte(0xff)
+	return len(c.chunk.Code) - 2
+}
+
+// patchJumps points several jump operands at the current position
+func (c *Compiler) patchJumps(offsets []int) {
+	for _, offset := range offsets {
+		c.patchJump(offset)
+	}
+}
+
+// patchJump points the jump operand at offset to the current position
+func (c *Compiler) patchJump(offset int) {
+	jump := len(c.chunk.Code) - offset - 2
+	if jump > 0xffff {
+		c.err = &CompileError{Message: "too much code to jump over", Expr: fmt.Sprintf("offset %d", offset)}
+	}
+	c.chunk.Code[offset] = byte(jump >> 8)
+	c.chunk.Code[offset+1] = byte(jump)
+}
+
+func (c *Compiler) emitLoop(loopStart int) {
+	c.emit(OpLoop)
+	offset := len(c.chunk.Code) - loopStart + 2
+	if offset > 0xffff {
+		c.err = &CompileError{Message: "loop body too large", Expr: fmt.Sprintf("offset %d", loopStart)}
+	}
+	c.emitByte(byte(offset >> 8))
+	c.emitByte(byte(offset))
+}
@@ -0,0 +1,135 @@
+package vm
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"moji/src/evaluator"
+	"moji/src/optimizer"
+	"moji/src/parser"
+	"moji/src/scanner"
+)
+
+// parse scans and parses source with line markers, as the run command does
+func parse(t *testing.T, source string) []string {
+	t.Helper()
+	s := scanner.NewScanner(source)
+	tokens := s.ScanTokens()
+	if s.HasError() {
+		t.Fatalf("scan error in %q", source)
+	}
+	p := parser.NewParser(tokens)
+	p.EnableLineMarkers()
+	statements, ok := p.TryParseStatements()
+	if !ok {
+		t.Fatalf("parse error in %q", source)
+	}
+	return statements
+}
+
+// evaluate runs source on the tree-walking evaluator and returns its output
+// followed by the error that stopped it, if any
+func evaluate(t *testing.T, source string, optimize bool, mode evaluator.NumericMode) string {
+	statements := parse(t, source)
+	if optimize {
+		statements = optimizer.Optimize(statements, mode)
+	}
+	var out bytes.Buffer
+	e := evaluator.NewEvaluator(nil)
+	e.SetNumericMode(mode)
+	e.SetOutput(&out)
+	if err := e.ExecuteStatements(statements); err != nil {
+		fmt.Fprintf(&out, "error: %v\n", err)
+	}
+	return out.String()
+}
+
+// execute compiles source and runs it on the VM
+func execute(t *testing.T, source string, mode evaluator.NumericMode) string {
+	chunk, err := Compile(optimizer.Optimize(parse(t, source), mode), mode)
+	if err != nil {
+		t.Fatalf("compile %q: %v", source, err)
+	}
+	var out bytes.Buffer
+	machine := NewVM(chunk)
+	machine.SetNumericMode(mode)
+	machine.SetOutput(&out)
+	if err := machine.Run(); err != nil {
+		fmt.Fprintf(&out, "error: %v\n", err)
+	}
+	return out.String()
+}
+
+func TestParity(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{`📢 "(";`, "(\n"},
+		{`📢 ")";`, ")\n"},
+		{`📢 ")(";`, ")(\n"},
+		{`📢 "a)b";`, "a)b\n"},
+		{`📢 "x)  (y";`, "x)  (y\n"},
+		{`📢 "a\b(";`, "a\\b(\n"},
+		{`🎁 s 👉 "((" + ")"; 📢 s;`, "(()\n"},
+		{`📢 ["(", ")("];`, "[\"(\", \")(\"]\n"},
+		{`🔀 (✅) { 📢 "(" + "x"; }`, "(x\n"},
+		{"📢 1;\n🛟 { 📢 \"12\" - 1; } 🥅 (e) { 📢 e[\"line\"]; }", "1\n2\n"},
+		{"📢 1;\n🛟 { 📢 1 / 0; } 🥅 (e) { 📢 e[\"line\"]; }", "1\n2\n"},
+		{"📢 1;\n\n📢 -✅;", "1\nerror: Operand must be a number.\n[line 3]\n"},
+		{`📢 len("नमस्ते"); 📢 "नमस्ते"[2];`, "3\nस्ते\n"},
+		{`📢 strings.replace("👍🏽ok", "", "-"); 📢 strings.replace("", "", "-");`, "-👍🏽-o-k-\n-\n"},
+		{`🎁 xs 👉 [1]; 📢 -xs;`, "error: Operand must be a number.\n[line 1]\n"},
+		{`📢 [1] + [2];`, "error: Operands must be two numbers or two strings.\n[line 1]\n"},
+		{`📢 "a" + [1];`, "error: Operands must be two numbers or two strings.\n[line 1]\n"},
+		{`📢 [1] + "a";`, "error: Operands must be two numbers or two strings.\n[line 1]\n"},
+		{`📢 🗺️{"a": 1} + 🗺️{"b": 2};`, "error: Operands must be two numbers or two strings.\n[line 1]\n"},
+		{`📢 "a" + 🗺️{"b": 2};`, "error: Operands must be two numbers or two strings.\n[line 1]\n"},
+		{`📢 🗺️{"a": 1} + "b";`, "error: Operands must be two numbers or two strings.\n[line 1]\n"},
+		{`🎁 xs 👉 [1]; 📢 xs < 2;`, "error: Operands must be numbers.\n[line 1]\n"},
+		{`🔀 ("a(" ⚖️ "b") 📢 "yes"; ↩️ 📢 "no";`, "no\n"},
+		{`🔀 ("}" ⚖️ "}") 📢 "yes";`, "yes\n"},
+		{`🔀 ("[" ⚖️ "]" or "{" ⚖️ "{") 📢 "{"; ↩️ 📢 "]";`, "{\n"},
+		{`🎁 i 👉 0; 🔄 (i < 2 and ")" ⚖️ ")") { 📢 i; i 👉 i + 1; }`, "0\n1\n"},
+	}
+
+	for _, test := range tests {
+		checkParity(t, test.source, evaluator.NumericFloat, test.want)
+	}
+}
+
+func TestNumericModeParity(t *testing.T) {
+	tests := []struct {
+		source      string
+		float, want string
+	}{
+		{`📢 0.1 + 0.2;`, "0.30000000000000004\n", "0.3\n"},
+		{`📢 1 / 4;`, "0.25\n", "0.25\n"},
+		{`📢 0.1 + 0.2 == 0.3;`, "false\n", "true\n"},
+		{`🎁 m 👉 🗺️{0.3: "x"}; 📢 has(m, 0.1 + 0.2);`, "false\n", "true\n"},
+		{`🔁 x in 0.1..0.3 step 0.1 { 📢 x; }`, "0.1\n0.2\n", "0.1\n0.2\n0.3\n"},
+	}
+
+	// Both modes run side by side, so neither can leak into the other
+	for _, test := range tests {
+		checkParity(t, test.source, evaluator.NumericFloat, test.float)
+		checkParity(t, test.source, evaluator.NumericDecimal, test.want)
+	}
+}
+
+// checkParity runs source on the evaluator, with and without the optimizer,
+// and on the VM, and checks they all print want
+func checkParity(t *testing.T, source string, mode evaluator.NumericMode, want string) {
+	t.Helper()
+	unoptimized := evaluate(t, source, false, mode)
+	if unoptimized != want {
+		t.Errorf("evaluator ran %q in %s mode: got %q, want %q", source, mode, unoptimized, want)
+	}
+	if got := evaluate(t, source, true, mode); got != unoptimized {
+		t.Errorf("optimized evaluator ran %q in %s mode: got %q, want %q", source, mode, got, unoptimized)
+	}
+	if got := execute(t, source, mode); got != unoptimized {
+		t.Errorf("VM ran %q in %s mode: got %q, want %q", source, mode, got, unoptimized)
+	}
+}
@@ -0,0 +1,430 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"moji/src/evaluator"
+)
+
+// VM executes a compiled chunk on a value stack. Values, environments and
+// errors are shared with the evaluator so both produce identical results.
+type VM struct {
+	chunk       *Chunk
+	ip          int
+	stack       []string
+	environment *evaluator.Environment
+	out         io.Writer
+	failure     string                // Pending assertion failure
+	iterators   []*evaluator.Iterator // Iterators of the for-each loops running, innermost last
+	handlers    []handler             // Error handlers of the try statements running, innermost last
+	limits      *evaluator.LimitTracker
+	importer    *evaluator.Importer
+	numeric     evaluator.NumericMode
+}
+
+// handler records where a try statement continues when an error is raised
+// inside it, and the state to go back to
+type handler struct {
+	ip          int
+	stack       int
+	iterators   int
+	environment *evaluator.Environment
+}
+
+// NewVM creates a VM for a compiled chunk
+func NewVM(chunk *Chunk) *VM {
+	return &VM{
+		chunk:       chunk,
+		stack:       make([]string, 0, 256),
+		environment: evaluator.NewEnvironment(),
+		out:         os.Stdout,
+		importer:    evaluator.NewImporter(evaluator.NewModuleLoader(nil), ""),
+	}
+}
+
+// SetModules makes the program import with loader, relative to file
+func (vm *VM) SetModules(loader *evaluator.ModuleLoader, file string) {
+	vm.importer = evaluator.NewImporter(loader, file)
+}
+
+// SetOutput redirects the output of print statements, which defaults to stdout
+func (vm *VM) SetOutput(w io.Writer) {
+	vm.out = w
+}
+
+// SetNumericMode selects how the VM computes numbers, which must be the
+// mode the chunk was compiled in
+func (vm *VM) SetNumericMode(mode evaluator.NumericMode) {
+	vm.numeric = mode
+}
+
+// SetLimits sets the resource limits enforced while running the chunk
+func (vm *VM) SetLimits(limits evaluator.Limits) {
+	vm.limits = evaluator.NewLimitTracker(limits)
+}
+
+// Run executes the chunk until it ends or a runtime error occurs
+func (vm *VM) Run() error {
+	return vm.RunContext(context.Background())
+}
+
+// RunContext is like Run, but stops with an evaluator.CancelledError when
+// ctx is done. The context is checked at every statement and loop back-edge.
+func (vm *VM) RunContext(ctx context.Context) error {
+	for {
+		err := vm.run(ctx)
+		caught, ok := evaluator.Catchable(err)
+		if !ok || len(vm.handlers) == 0 {
+			return err
+		}
+		vm.catch(caught)
+	}
+}
+
+// catch continues at the innermost error handler, leaving the scopes and
+// loops entered since its try statement began
+func (vm *VM) catch(err *evaluator.RuntimeError) {
+	h := vm.handlers[len(vm.handlers)-1]
+	vm.handlers = vm.handlers[:len(vm.handlers)-1]
+	for ; vm.environment != h.environment; vm.environment = vm.environment.Enclosing() {
+		if vm.limits != nil {
+			vm.limits.LeaveScope()
+		}
+	}
+	vm.stack = vm.stack[:h.stack]
+	vm.iterators = vm.iterators[:h.iterators]
+	vm.push(evaluator.CaughtValue(err))
+	vm.push(strconv.Itoa(err.Line))
+	vm.ip = h.ip
+}
+
+// run executes instructions until the chunk ends or an error is raised
+func (vm *VM) run(ctx context.Context) error {
+	cancellable := ctx.Done() != nil
+	code := vm.chunk.Code
+	for vm.ip < len(code) {
+		op := Opcode(code[vm.ip])
+		line := vm.chunk.Lines[vm.ip]
+		if vm.limits != nil {
+			if err := vm.checkStatements(line); err != nil {
+				return err
+			}
+		}
+		if cancellable && vm.ip < len(vm.chunk.Statements) && vm.chunk.Statements[vm.ip] > 0 {
+			if err := evaluator.CheckContext(ctx, line); err != nil {
+				return err
+			}
+		}
+		vm.ip++
+
+		switch op {
+		case OpConstant:
+			vm.push(vm.chunk.Constants[vm.readUint16()])
+		case OpNil:
+			vm.push("nil")
+		case OpTrue:
+			vm.push("true")
+		case OpFalse:
+			vm.push("false")
+		case OpPop:
+			vm.pop()
+		case OpDefine:
+			vm.environment.Define(vm.chunk.Constants[vm.readUint16()], vm.pop())
+		case OpGet:
+			value, err := vm.environment.Get(vm.chunk.Constants[vm.readUint16()])
+			if err != nil {
+				if runtimeErr, ok := err.(*evaluator.RuntimeError); ok {
+					runtimeErr.Line = line
+				}
+				return err
+			}
+			vm.push(value)
+		case OpSet:
+			if _, err := vm.environment.Assign(vm.chunk.Constants[vm.readUint16()], vm.peek(), line); err != nil {
+				return err
+			}
+		case OpDefineLocal:
+			name := vm.chunk.Constants[vm.readUint16()]
+			slot := int(code[vm.ip])
+			vm.ip++
+			vm.environment.DefineSlot(slot, name, vm.pop())
+		case OpDefineConst:
+			vm.environment.DefineConstant(vm.chunk.Constants[vm.readUint16()], vm.pop())
+		case OpLocalConst:
+			name := vm.chunk.Constants[vm.readUint16()]
+			slot := int(code[vm.ip])
+			vm.ip++
+			vm.environment.DefineConstantSlot(slot, name, vm.pop())
+		case OpGetLocal:
+			name := vm.chunk.Constants[vm.readUint16()]
+			depth, slot := int(code[vm.ip]), int(code[vm.ip+1])
+			vm.ip += 2
+			value, err := vm.environment.GetSlot(depth, slot, name)
+			if err != nil {
+				if runtimeErr, ok := err.(*evaluator.RuntimeError); ok {
+					runtimeErr.Line = line
+				}
+				return err
+			}
+			vm.push(value)
+		case OpSetLocal:
+			name := vm.chunk.Constants[vm.readUint16()]
+			depth, slot := int(code[vm.ip]), int(code[vm.ip+1])
+			vm.ip += 2
+			if _, err := vm.environment.AssignSlot(depth, slot, name, vm.peek(), line); err != nil {
+				return err
+			}
+		case OpAdd, OpSubtract, OpMultiply, OpDivide, OpModulo, OpFloorDivide, OpPower, OpEqual, OpNotEqual,
+			OpGreater, OpGreaterEqual, OpLess, OpLessEqual:
+			right := vm.pop()
+			left := vm.pop()
+			result, err := evaluator.BinaryOp(vm.numeric, binaryOperators[op], left, right)
+			if err != nil {
+				return atLine(err, line)
+			}
+			if op == OpAdd {
+				if err := vm.checkSize(result, line); err != nil {
+					return err
+				}
+			}
+			vm.push(result)
+		case OpNegate:
+			result, err := evaluator.UnaryOp(vm.numeric, "-", vm.pop())
+			if err != nil {
+				return atLine(err, line)
+			}
+			vm.push(result)
+		case OpNot:
+			result, _ := evaluator.UnaryOp(vm.numeric, "!", vm.pop())
+			vm.push(result)
+		case OpList:
+			result := evaluator.ListValue(vm.popN(vm.readUint16()))
+			if err := vm.checkSize(result, line); err != nil {
+				return err
+			}
+			vm.push(result)
+		case OpMap:
+			result, err := evaluator.MapValue(vm.numeric, vm.popN(2*vm.readUint16()))
+			if err != nil {
+				return atLine(err, line)
+			}
+			if err := vm.checkSize(result, line); err != nil {
+				return err
+			}
+			vm.push(result)
+		case OpIndex:
+			index := vm.pop()
+			result, err := evaluator.IndexValue(vm.numeric, vm.pop(), index)
+			if err != nil {
+				return atLine(err, line)
+			}
+			vm.push(result)
+		case OpSlice:
+			end := vm.pop()
+			start := vm.pop()
+			result, err := evaluator.SliceValue(vm.pop(), start, end)
+			if err != nil {
+				return atLine(err, line)
+			}
+			vm.push(result)
+		case OpSetIndex:
+			count := int(code[vm.ip])
+			vm.ip++
+			value := vm.pop()
+			indices := vm.popN(count)
+			result, err := evaluator.SetIndexValue(vm.numeric, vm.pop(), indices, value)
+			if err != nil {
+				return atLine(err, line)
+			}
+			if err := vm.checkSize(result, line); err != nil {
+				return err
+			}
+			vm.push(value)
+			vm.push(result)
+		case OpCall:
+			name := vm.chunk.Constants[vm.readUint16()]
+			count := int(code[vm.ip])
+			vm.ip++
+			result, err := evaluator.CallBuiltin(name, vm.popN(count), line, vm.numeric, vm.limits)
+			if err != nil {
+				return err
+			}
+			vm.push(result)
+		case OpPrint:
+			fmt.Fprintln(vm.out, evaluator.PrintableValue(vm.pop()))
+		case OpJump:
+			offset := vm.readUint16()
+			vm.ip += offset
+		case OpJumpIfFalse:
+			offset := vm.readUint16()
+			if !evaluator.IsTruthy(vm.peek()) {
+				vm.ip += offset
+			}
+		case OpJumpIfNotNil:
+			offset := vm.readUint16()
+			if vm.peek() != "nil" {
+				vm.ip += offset
+			}
+		case OpLoop:
+			offset := vm.readUint16()
+			vm.ip -= offset
+			if cancellable {
+				if err := evaluator.CheckContext(ctx, line); err != nil {
+					return err
+				}
+			}
+		case OpPushScope:
+			if vm.limits != nil {
+				if err := vm.limits.EnterScope(line); err != nil {
+					return err
+				}
+			}
+			vm.environment = evaluator.NewLocalEnvironment(vm.environment)
+		case OpPopScope:
+			if vm.limits != nil {
+				vm.limits.LeaveScope()
+			}
+			vm.environment = vm.environment.Enclosing()
+		case OpIterate:
+			it, err := evaluator.NewIterator(vm.pop())
+			if err != nil {
+				return atLine(err, line)
+			}
+			vm.iterators = append(vm.iterators, it)
+		case OpRange:
+			bounds := vm.popN(3)
+			it, err := evaluator.NewRangeIterator(vm.numeric, bounds[0], bounds[1], bounds[2])
+			if err != nil {
+				return atLine(err, line)
+			}
+			vm.iterators = append(vm.iterators, it)
+		case OpForEach:
+			count := int(code[vm.ip])
+			vm.ip++
+			offset := vm.readUint16()
+			values, ok := vm.iterators[len(vm.iterators)-1].Next(count)
+			if !ok {
+				vm.ip += offset
+				break
+			}
+			vm.stack = append(vm.stack, values...)
+		case OpEndIterate:
+			vm.iterators = vm.iterators[:len(vm.iterators)-1]
+		case OpDup:
+			vm.push(vm.peek())
+		case OpMatchRange:
+			bounds := vm.popN(2)
+			if evaluator.MatchesRange(vm.numeric, vm.peek(), bounds[0], bounds[1]) {
+				vm.push("true")
+			} else {
+				vm.push("false")
+			}
+		case OpTry:
+			offset := vm.readUint16()
+			vm.handlers = append(vm.handlers, handler{
+				ip:          vm.ip + offset,
+				stack:       len(vm.stack),
+				iterators:   len(vm.iterators),
+				environment: vm.environment,
+			})
+		case OpEndTry:
+			vm.handlers = vm.handlers[:len(vm.handlers)-1]
+		case OpThrow:
+			return evaluator.Thrown(vm.pop(), line)
+		case OpRethrow:
+			caughtLine, _ := strconv.Atoi(vm.pop())
+			return evaluator.Thrown(vm.pop(), caughtLine)
+		case OpImport:
+			name := vm.chunk.Constants[vm.readUint16()]
+			if err := vm.importer.Import(ctx, vm.environment, vm.pop(), name, vm.out, vm.numeric, vm.limits); err != nil {
+				return atLine(err, line)
+			}
+		case OpAssert:
+			equality := code[vm.ip] == 1
+			vm.ip++
+			offset := vm.readUint16()
+			if equality {
+				expected := vm.pop()
+				actual := vm.pop()
+				vm.failure = evaluator.EqualityFailure(vm.numeric, actual, expected)
+			} else {
+				vm.failure = evaluator.TruthyFailure(vm.pop())
+			}
+			if vm.failure == "" {
+				vm.ip += offset
+			}
+		case OpAssertFail:
+			hasMessage := code[vm.ip] == 1
+			vm.ip++
+			message := ""
+			if hasMessage {
+				message = vm.pop()
+			}
+			return evaluator.AssertionFailed(vm.failure, message, hasMessage, line)
+		default:
+			return fmt.Errorf("unknown opcode %d at offset %d", op, vm.ip-1)
+		}
+	}
+	return nil
+}
+
+// checkSize applies the length limit, if any, to a value built on line
+func (vm *VM) checkSize(value string, line int) error {
+	if vm.limits == nil {
+		return nil
+	}
+	return vm.limits.Value(value, line)
+}
+
+// checkStatements counts the statements starting at the current instruction
+func (vm *VM) checkStatements(line int) error {
+	if vm.ip >= len(vm.chunk.Statements) {
+		return nil
+	}
+	for i := 0; i < vm.chunk.Statements[vm.ip]; i++ {
+		if err := vm.limits.Statement(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (vm *VM) readUint16() int {
+	value := vm.chunk.readUint16(vm.ip)
+	vm.ip += 2
+	return value
+}
+
+func (vm *VM) push(value string) {
+	vm.stack = append(vm.stack, value)
+}
+
+func (vm *VM) pop() string {
+	value := vm.stack[len(vm.stack)-1]
+	vm.stack = vm.stack[:len(vm.stack)-1]
+	return value
+}
+
+// popN pops count values, returning them in the order they were pushed
+func (vm *VM) popN(count int) []string {
+	values := append([]string(nil), vm.stack[len(vm.stack)-count:]...)
+	vm.stack = vm.stack[:len(vm.stack)-count]
+	return values
+}
+
+// atLine reports a runtime error raised without a line on the line of the
+// instruction that raised it
+func atLine(err error, line int) error {
+	if runtimeErr, ok := err.(*evaluator.RuntimeError); ok && runtimeErr.Line == 0 {
+		runtimeErr.Line = line
+	}
+	return err
+}
+
+func (vm *VM) peek() string {
+	return vm.stack[len(vm.stack)-1]
+}